@@ -0,0 +1,332 @@
+package main
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"os"
+
+	pb "github.com/brotherlogic/cdprocessor/proto"
+)
+
+type readerAt interface {
+	ReadAt(p []byte, off int64) (int, error)
+}
+
+// readFull reads exactly n bytes at the offset, or fails
+func readFull(r readerAt, off int64, n int) ([]byte, error) {
+	buf := make([]byte, n)
+	read, err := r.ReadAt(buf, off)
+	if read < n {
+		return nil, fmt.Errorf("short read of %v bytes at %v: %v", n, off, err)
+	}
+	return buf, nil
+}
+
+// readAudioInfo reads the stats and headers of an audio file, recording rather than returning any problem
+func readAudioInfo(path, format string, info os.FileInfo) *pb.AudioInfo {
+	result := &pb.AudioInfo{Size: info.Size(), Mtime: info.ModTime().Unix()}
+
+	f, err := os.Open(path)
+	if err != nil {
+		result.Error = err.Error()
+		return result
+	}
+	defer f.Close()
+
+	var header *pb.AudioInfo
+	switch format {
+	case "wav":
+		header, err = readWavInfo(f, info.Size())
+	case "flac":
+		header, err = readFlacInfo(f, info.Size())
+	case "mp3":
+		header, err = readMp3Info(f, info.Size())
+	default:
+		err = fmt.Errorf("unknown format %v", format)
+	}
+	if err != nil {
+		result.Error = err.Error()
+		return result
+	}
+
+	header.Size = result.Size
+	header.Mtime = result.Mtime
+	return header
+}
+
+// readWavInfo reads the fmt and data chunks of a RIFF WAVE file
+func readWavInfo(r readerAt, size int64) (*pb.AudioInfo, error) {
+	header, err := readFull(r, 0, 12)
+	if err != nil {
+		return nil, err
+	}
+	if string(header[0:4]) != "RIFF" || string(header[8:12]) != "WAVE" {
+		return nil, fmt.Errorf("not a wav file")
+	}
+
+	info := &pb.AudioInfo{}
+	byteRate := int64(0)
+	for off := int64(12); off+8 <= size; {
+		chunk, err := readFull(r, off, 8)
+		if err != nil {
+			return nil, err
+		}
+		length := int64(binary.LittleEndian.Uint32(chunk[4:8]))
+
+		switch string(chunk[0:4]) {
+		case "fmt ":
+			fmtChunk, err := readFull(r, off+8, 16)
+			if err != nil {
+				return nil, err
+			}
+			info.Channels = int32(binary.LittleEndian.Uint16(fmtChunk[2:4]))
+			info.SampleRate = int32(binary.LittleEndian.Uint32(fmtChunk[4:8]))
+			byteRate = int64(binary.LittleEndian.Uint32(fmtChunk[8:12]))
+			info.BitDepth = int32(binary.LittleEndian.Uint16(fmtChunk[14:16]))
+			info.Bitrate = int32(byteRate * 8 / 1000)
+		case "data":
+			if byteRate == 0 {
+				return nil, fmt.Errorf("data chunk before fmt chunk")
+			}
+			// Rippers that stream the wav out can leave the length unset
+			if length == 0 || off+8+length > size {
+				length = size - off - 8
+			}
+			info.DurationMs = length * 1000 / byteRate
+			return info, nil
+		}
+
+		// Chunks are padded to an even length
+		off += 8 + length + length%2
+	}
+
+	return nil, fmt.Errorf("no data chunk")
+}
+
+// skipID3 returns the length of any ID3v2 tag at the start of the file
+func skipID3(r readerAt) int64 {
+	header, err := readFull(r, 0, 10)
+	if err != nil || string(header[0:3]) != "ID3" {
+		return 0
+	}
+
+	length := int64(header[6])<<21 | int64(header[7])<<14 | int64(header[8])<<7 | int64(header[9])
+	if header[5]&0x10 != 0 {
+		length += 10
+	}
+	return 10 + length
+}
+
+// flacStreamInfo is the STREAMINFO block every flac file starts with
+type flacStreamInfo struct {
+	sampleRate   int32
+	channels     int32
+	bitDepth     int32
+	totalSamples int64
+	md5          []byte
+}
+
+func readStreamInfo(r readerAt) (*flacStreamInfo, error) {
+	start := skipID3(r)
+	header, err := readFull(r, start, 8)
+	if err != nil {
+		return nil, err
+	}
+	if string(header[0:4]) != "fLaC" {
+		return nil, fmt.Errorf("not a flac file")
+	}
+	if header[4]&0x7f != 0 {
+		return nil, fmt.Errorf("first metadata block is type %v, not STREAMINFO", header[4]&0x7f)
+	}
+
+	block, err := readFull(r, start+8, 34)
+	if err != nil {
+		return nil, err
+	}
+	packed := binary.BigEndian.Uint64(block[10:18])
+	return &flacStreamInfo{
+		sampleRate:   int32(packed >> 44),
+		channels:     int32((packed>>41)&0x7) + 1,
+		bitDepth:     int32((packed>>36)&0x1f) + 1,
+		totalSamples: int64(packed & 0xfffffffff),
+		md5:          block[18:34],
+	}, nil
+}
+
+// readFlacInfo reads the STREAMINFO block of a flac file
+func readFlacInfo(r readerAt, size int64) (*pb.AudioInfo, error) {
+	stream, err := readStreamInfo(r)
+	if err != nil {
+		return nil, err
+	}
+	if stream.sampleRate == 0 {
+		return nil, fmt.Errorf("flac has no sample rate")
+	}
+
+	info := &pb.AudioInfo{SampleRate: stream.sampleRate, Channels: stream.channels, BitDepth: stream.bitDepth}
+	info.DurationMs = stream.totalSamples * 1000 / int64(stream.sampleRate)
+	if info.DurationMs > 0 {
+		info.Bitrate = int32(size * 8 / info.DurationMs)
+	}
+	return info, nil
+}
+
+var (
+	// mp3Bitrates in kbps, indexed by [version/layer row][bitrate index]
+	mp3Bitrates = [5][16]int32{
+		{0, 32, 64, 96, 128, 160, 192, 224, 256, 288, 320, 352, 384, 416, 448, 0}, // MPEG1 layer I
+		{0, 32, 48, 56, 64, 80, 96, 112, 128, 160, 192, 224, 256, 320, 384, 0},    // MPEG1 layer II
+		{0, 32, 40, 48, 56, 64, 80, 96, 112, 128, 160, 192, 224, 256, 320, 0},     // MPEG1 layer III
+		{0, 32, 48, 56, 64, 80, 96, 112, 128, 144, 160, 176, 192, 224, 256, 0},    // MPEG2 layer I
+		{0, 8, 16, 24, 32, 40, 48, 56, 64, 80, 96, 112, 128, 144, 160, 0},         // MPEG2 layers II and III
+	}
+
+	// mp3SampleRates indexed by [version bits][sample rate index]
+	mp3SampleRates = [4][3]int32{
+		{11025, 12000, 8000},  // MPEG2.5
+		{0, 0, 0},             // reserved
+		{22050, 24000, 16000}, // MPEG2
+		{44100, 48000, 32000}, // MPEG1
+	}
+)
+
+// mp3Frame is a decoded mp3 frame header
+type mp3Frame struct {
+	mpeg1      bool
+	layer      int
+	bitrate    int32
+	sampleRate int32
+	mono       bool
+	length     int64
+	samples    int64
+}
+
+func parseMp3Frame(header []byte) (*mp3Frame, error) {
+	if header[0] != 0xff || header[1]&0xe0 != 0xe0 {
+		return nil, fmt.Errorf("no frame sync")
+	}
+
+	version := (header[1] >> 3) & 0x3
+	layerBits := (header[1] >> 1) & 0x3
+	bitrateIndex := header[2] >> 4
+	rateIndex := (header[2] >> 2) & 0x3
+	if version == 1 || layerBits == 0 || bitrateIndex == 0 || bitrateIndex == 15 || rateIndex == 3 {
+		return nil, fmt.Errorf("bad frame header %x", header)
+	}
+
+	frame := &mp3Frame{mpeg1: version == 3, layer: 4 - int(layerBits), mono: header[3]>>6 == 3}
+	row := frame.layer - 1
+	if !frame.mpeg1 {
+		row = 4
+		if frame.layer == 1 {
+			row = 3
+		}
+	}
+	frame.bitrate = mp3Bitrates[row][bitrateIndex]
+	frame.sampleRate = mp3SampleRates[version][rateIndex]
+	padding := int64((header[2] >> 1) & 0x1)
+
+	br, sr := int64(frame.bitrate)*1000, int64(frame.sampleRate)
+	switch {
+	case frame.layer == 1:
+		frame.samples = 384
+		frame.length = (12*br/sr + padding) * 4
+	case frame.layer == 3 && !frame.mpeg1:
+		frame.samples = 576
+		frame.length = 72*br/sr + padding
+	default:
+		frame.samples = 1152
+		frame.length = 144*br/sr + padding
+	}
+	return frame, nil
+}
+
+// xingFrames reads the frame count from a Xing, Info or VBRI header in the first frame
+func xingFrames(frame *mp3Frame, data []byte) int64 {
+	side := 17
+	if frame.mpeg1 && !frame.mono {
+		side = 32
+	} else if !frame.mpeg1 && frame.mono {
+		side = 9
+	}
+
+	if off := 4 + side; len(data) >= off+12 {
+		tag := string(data[off : off+4])
+		if (tag == "Xing" || tag == "Info") && binary.BigEndian.Uint32(data[off+4:off+8])&0x1 != 0 {
+			return int64(binary.BigEndian.Uint32(data[off+8 : off+12]))
+		}
+	}
+	if off := 4 + 32; len(data) >= off+18 && string(data[off:off+4]) == "VBRI" {
+		return int64(binary.BigEndian.Uint32(data[off+14 : off+18]))
+	}
+	return 0
+}
+
+// mp3Scan is how far we look for the first frame past any tag
+const mp3Scan = 64 * 1024
+
+// findMp3Frame finds the first frame at or after the offset, checking that another frame follows it
+func findMp3Frame(r readerAt, start, size int64) (int64, *mp3Frame, []byte, error) {
+	length := int64(mp3Scan)
+	if start+length > size {
+		length = size - start
+	}
+	if length < 4 {
+		return 0, nil, nil, fmt.Errorf("no mp3 frames")
+	}
+	data, err := readFull(r, start, int(length))
+	if err != nil {
+		return 0, nil, nil, err
+	}
+
+	for i := 0; i+4 <= len(data); i++ {
+		if data[i] != 0xff {
+			continue
+		}
+		frame, err := parseMp3Frame(data[i : i+4])
+		if err != nil {
+			continue
+		}
+
+		next := i + int(frame.length)
+		if next+4 <= len(data) {
+			if _, err := parseMp3Frame(data[next : next+4]); err != nil {
+				continue
+			}
+		} else if start+int64(next) != size {
+			continue
+		}
+		return start + int64(i), frame, data[i:], nil
+	}
+	return 0, nil, nil, fmt.Errorf("no mp3 frames")
+}
+
+// readMp3Info reads the first frame header of an mp3, along with any VBR header it carries
+func readMp3Info(r readerAt, size int64) (*pb.AudioInfo, error) {
+	off, frame, data, err := findMp3Frame(r, skipID3(r), size)
+	if err != nil {
+		return nil, err
+	}
+
+	channels := int32(2)
+	if frame.mono {
+		channels = 1
+	}
+	info := &pb.AudioInfo{SampleRate: frame.sampleRate, Channels: channels, Bitrate: frame.bitrate}
+
+	audio := size - off
+	if tag, err := readFull(r, size-128, 3); err == nil && bytes.Equal(tag, []byte("TAG")) {
+		audio -= 128
+	}
+
+	if frames := xingFrames(frame, data); frames > 0 {
+		info.DurationMs = frames * frame.samples * 1000 / int64(frame.sampleRate)
+		if info.DurationMs > 0 {
+			info.Bitrate = int32(audio * 8 / info.DurationMs)
+		}
+	} else {
+		info.DurationMs = audio * 8 / int64(frame.bitrate)
+	}
+	return info, nil
+}
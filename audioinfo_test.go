@@ -0,0 +1,156 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func makeWav(rate, channels, bits, seconds int) []byte {
+	data := rate * channels * bits / 8 * seconds
+	buf := &bytes.Buffer{}
+	buf.WriteString("RIFF")
+	binary.Write(buf, binary.LittleEndian, uint32(36+data+12))
+	buf.WriteString("WAVE")
+
+	// A LIST chunk ahead of fmt, as some rippers write
+	buf.WriteString("LIST")
+	binary.Write(buf, binary.LittleEndian, uint32(3))
+	buf.Write([]byte{1, 2, 3, 0})
+
+	buf.WriteString("fmt ")
+	binary.Write(buf, binary.LittleEndian, uint32(16))
+	binary.Write(buf, binary.LittleEndian, uint16(1))
+	binary.Write(buf, binary.LittleEndian, uint16(channels))
+	binary.Write(buf, binary.LittleEndian, uint32(rate))
+	binary.Write(buf, binary.LittleEndian, uint32(rate*channels*bits/8))
+	binary.Write(buf, binary.LittleEndian, uint16(channels*bits/8))
+	binary.Write(buf, binary.LittleEndian, uint16(bits))
+	buf.WriteString("data")
+	binary.Write(buf, binary.LittleEndian, uint32(data))
+	buf.Write(make([]byte, data))
+	return buf.Bytes()
+}
+
+func makeFlac(rate, channels, bits int, samples int64) []byte {
+	buf := &bytes.Buffer{}
+	buf.WriteString("fLaC")
+	buf.Write([]byte{0x80, 0, 0, 34})
+	block := make([]byte, 34)
+	packed := uint64(rate)<<44 | uint64(channels-1)<<41 | uint64(bits-1)<<36 | uint64(samples)
+	binary.BigEndian.PutUint64(block[10:18], packed)
+	buf.Write(block)
+	buf.Write(make([]byte, 1000))
+	return buf.Bytes()
+}
+
+// makeMp3 builds MPEG1 layer III frames at 128kbps and 44.1kHz, with a Xing header if xing > 0
+func makeMp3(frames int, xing uint32) []byte {
+	buf := &bytes.Buffer{}
+	buf.WriteString("ID3")
+	buf.Write([]byte{4, 0, 0, 0, 0, 0, 10})
+	buf.Write(make([]byte, 10))
+	for i := 0; i < frames; i++ {
+		frame := make([]byte, 417)
+		copy(frame, []byte{0xff, 0xfb, 0x90, 0x00})
+		if i == 0 && xing > 0 {
+			copy(frame[36:], "Xing")
+			binary.BigEndian.PutUint32(frame[40:44], 1)
+			binary.BigEndian.PutUint32(frame[44:48], xing)
+		}
+		buf.Write(frame)
+	}
+	return buf.Bytes()
+}
+
+func TestReadWavInfo(t *testing.T) {
+	data := makeWav(44100, 2, 16, 3)
+	info, err := readWavInfo(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		t.Fatalf("Bad read: %v", err)
+	}
+	if info.GetSampleRate() != 44100 || info.GetChannels() != 2 || info.GetBitDepth() != 16 || info.GetDurationMs() != 3000 || info.GetBitrate() != 1411 {
+		t.Errorf("Bad wav info: %v", info)
+	}
+
+	if _, err := readWavInfo(bytes.NewReader(data[:40]), 40); err == nil {
+		t.Errorf("Truncated wav was read")
+	}
+}
+
+func TestReadFlacInfo(t *testing.T) {
+	data := makeFlac(96000, 2, 24, 96000*5)
+	info, err := readFlacInfo(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		t.Fatalf("Bad read: %v", err)
+	}
+	if info.GetSampleRate() != 96000 || info.GetChannels() != 2 || info.GetBitDepth() != 24 || info.GetDurationMs() != 5000 {
+		t.Errorf("Bad flac info: %v", info)
+	}
+
+	if _, err := readFlacInfo(bytes.NewReader(makeWav(44100, 2, 16, 1)), 100); err == nil {
+		t.Errorf("Wav was read as flac")
+	}
+}
+
+func TestReadMp3Info(t *testing.T) {
+	data := makeMp3(100, 0)
+	info, err := readMp3Info(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		t.Fatalf("Bad read: %v", err)
+	}
+	// 100 frames of 1152 samples is 2612ms, CBR estimates from the size
+	if info.GetSampleRate() != 44100 || info.GetChannels() != 2 || info.GetBitrate() != 128 || info.GetDurationMs() < 2550 || info.GetDurationMs() > 2650 {
+		t.Errorf("Bad mp3 info: %v", info)
+	}
+}
+
+func TestReadMp3InfoXing(t *testing.T) {
+	data := makeMp3(10, 1000)
+	info, err := readMp3Info(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		t.Fatalf("Bad read: %v", err)
+	}
+	if info.GetDurationMs() != 26122 {
+		t.Errorf("Bad xing duration: %v", info)
+	}
+}
+
+func TestReadMp3InfoNoFrames(t *testing.T) {
+	data := make([]byte, 2000)
+	data[100] = 0xff
+	data[101] = 0xfb
+	data[102] = 0x90
+	if _, err := readMp3Info(bytes.NewReader(data), int64(len(data))); err == nil {
+		t.Errorf("Lone false sync was read as an mp3")
+	}
+}
+
+func TestTracksFromAudioInfo(t *testing.T) {
+	dir := t.TempDir() + "/"
+	os.MkdirAll(filepath.Join(dir, "123"), 0755)
+	os.WriteFile(filepath.Join(dir, "123", "track01.cdda.wav"), makeWav(44100, 2, 16, 2), 0644)
+	os.WriteFile(filepath.Join(dir, "123", "track01.cdda.flac"), makeFlac(44100, 2, 16, 44100*2), 0644)
+	os.WriteFile(filepath.Join(dir, "123", "track01.cdda.mp3"), []byte{}, 0644)
+
+	s := InitTestServer(dir)
+	files, err := s.io.readSubdir("123")
+	if err != nil {
+		t.Fatalf("Bad read: %v", err)
+	}
+
+	tracks, _ := s.tracksFrom(context.Background(), "123", 1, files, nil)
+	if len(tracks) != 1 {
+		t.Fatalf("Bad tracks: %v", tracks)
+	}
+	track := tracks[0]
+	if track.GetWav().GetDurationMs() != 2000 || track.GetFlac().GetDurationMs() != 2000 || track.GetWav().GetSize() == 0 || track.GetWav().GetMtime() == 0 {
+		t.Errorf("Bad audio info: %v", track)
+	}
+	if len(track.GetMp3().GetError()) == 0 {
+		t.Errorf("Empty mp3 was read: %v", track.GetMp3())
+	}
+}
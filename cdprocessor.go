@@ -7,8 +7,6 @@ import (
 	"log"
 	"os"
 	"os/exec"
-	"strconv"
-	"strings"
 	"sync"
 	"time"
 
@@ -31,6 +29,9 @@ import (
 const (
 	// KEY - where the config is stored
 	KEY = "/github.com/brotherlogic/cdprocessor/config"
+
+	// configRetries - how many times updateConfig will reload after losing a race
+	configRetries = 5
 )
 
 type ripper interface {
@@ -157,11 +158,16 @@ func (rc *prodGetter) updateRecord(ctx context.Context, instanceID int32, cdpath
 type io interface {
 	readDir() ([]os.FileInfo, error)
 	readSubdir(f string) ([]os.FileInfo, error)
+	stat(path string) (os.FileInfo, error)
 	convert(name string) (int32, int32, error)
+	audioInfo(path, format string, info os.FileInfo) *pb.AudioInfo
+	checkAudio(path, format string) error
+	remove(path string) error
 }
 
 type rc interface {
 	getRecordsInFolder(ctx context.Context, folder int32) ([]*pbrc.Record, error)
+	getInstanceIds(ctx context.Context, releaseID int32) ([]int32, error)
 }
 
 type prodRc struct {
@@ -177,7 +183,7 @@ func (rc *prodRc) getRecordsInFolder(ctx context.Context, folder int32) ([]*pbrc
 	defer conn.Close()
 
 	client := pbrc.NewRecordCollectionServiceClient(conn)
-	ids, err := client.QueryRecords(ctx, &pbrc.QueryRecordsRequest{Query: &pbrc.QueryRecordsRequest_FolderId{folder}})
+	ids, err := client.QueryRecords(ctx, &pbrc.QueryRecordsRequest{Query: &pbrc.QueryRecordsRequest_FolderId{FolderId: folder}})
 	if err != nil {
 		return nil, err
 	}
@@ -194,9 +200,26 @@ func (rc *prodRc) getRecordsInFolder(ctx context.Context, folder int32) ([]*pbrc
 	return recs, nil
 }
 
+func (rc *prodRc) getInstanceIds(ctx context.Context, releaseID int32) ([]int32, error) {
+	conn, err := rc.dial(ctx, "recordcollection")
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	client := pbrc.NewRecordCollectionServiceClient(conn)
+	ids, err := client.QueryRecords(ctx, &pbrc.QueryRecordsRequest{Query: &pbrc.QueryRecordsRequest_ReleaseId{ReleaseId: releaseID}})
+	if err != nil {
+		return nil, err
+	}
+
+	return ids.GetInstanceIds(), nil
+}
+
 type prodIo struct {
-	dir string
-	log func(ctx context.Context, s string)
+	dir     string
+	log     func(ctx context.Context, s string)
+	layouts []*ripLayout
 }
 
 func (i *prodIo) readDir() ([]os.FileInfo, error) {
@@ -207,45 +230,76 @@ func (i *prodIo) readSubdir(f string) ([]os.FileInfo, error) {
 	return ioutil.ReadDir(i.dir + f)
 }
 
+func (i *prodIo) stat(path string) (os.FileInfo, error) {
+	return os.Stat(i.dir + path)
+}
+
 func (i *prodIo) convert(name string) (int32, int32, error) {
-	if strings.Contains(name, "_") {
-		val, err := strconv.ParseInt(name[:strings.Index(name, "_")], 10, 32)
-		if err != nil {
-			return -1, -1, err
-		}
-		dval, err := strconv.ParseInt(name[strings.Index(name, "_")+1:], 10, 32)
-		if err != nil {
-			return -1, -1, err
-		}
-		return int32(val), int32(dval), nil
-	}
+	id, disk, _, err := matchLayout(i.layouts, name)
+	return id, disk, err
+}
 
-	val, err := strconv.ParseInt(name, 10, 32)
-	if err != nil {
-		return -1, -1, err
-	}
-	return int32(val), 1, nil
+func (i *prodIo) audioInfo(path, format string, info os.FileInfo) *pb.AudioInfo {
+	return readAudioInfo(i.dir+path, format, info)
+}
+
+func (i *prodIo) checkAudio(path, format string) error {
+	return checkAudioFile(i.dir+path, format)
+}
+
+func (i *prodIo) remove(path string) error {
+	return os.Remove(i.dir + path)
 }
 
 // Server main server type
 type Server struct {
 	*goserver.GoServer
-	io          io
-	rc          rc
-	getter      getter
-	lastRunTime time.Duration
-	adjust      int
-	rips        []*pb.Rip
-	ripCount    int64
-	flacCount   int64
-	dir         string
-	ripper      ripper
-	mp3dir      string
-	flacdir     string
-	forceCheck  bool
-	master      master
-	count       int64
-	hack        *sync.Mutex
+	io           io
+	rc           rc
+	getter       getter
+	lastRunTime  time.Duration
+	adjust       int
+	rips         []*pb.Rip
+	ripCount     int64
+	flacCount    int64
+	dir          string
+	ripper       ripper
+	mp3dir       string
+	flacdir      string
+	forceCheck   bool
+	master       master
+	count        int64
+	hack         *sync.Mutex
+	watchers     map[int]chan *pb.RipEvent
+	watchCount   int
+	watchLock    *sync.Mutex
+	store        configStore
+	saveLock     *sync.Mutex
+	history      *pb.History
+	historyLock  *sync.Mutex
+	historyDirty bool
+	ripLock      *sync.Mutex
+	scanLock     *sync.Mutex
+	ripWatching  bool
+	ripWatcher   func(string) error
+	scanWorkers  int
+	layouts      []*ripLayout
+	node         string
+
+	// A watched directory is reread once it has settled, or gone quiet if it's being written
+	watchSettle time.Duration
+	watchQuiet  time.Duration
+
+	// When we last queued a conversion of each output
+	conversions    map[string]time.Time
+	conversionLock *sync.Mutex
+
+	// The manifest and scrub progress are both under manifestLock
+	manifest      *pb.Manifest
+	manifestLock  *sync.Mutex
+	manifestDirty bool
+	scrub         *pb.ScrubProgress
+	scrubRate     int64
 }
 
 // Init builds the server
@@ -259,22 +313,86 @@ func Init(dir string, mp3dir string, flacdir string) *Server {
 		flacdir: flacdir,
 	}
 	s.rc = &prodRc{dial: s.FDialServer, log: s.CtxLog}
-	s.io = &prodIo{dir: dir, log: s.CtxLog}
+	s.layouts = defaultLayouts
+	s.node, _ = os.Hostname()
+	s.io = &prodIo{dir: dir, log: s.CtxLog, layouts: s.layouts}
 	s.getter = &prodGetter{log: s.CtxLog, dial: s.FDialServer}
 	s.ripper = &prodRipper{log: s.CtxLog, server: s.resolve, dial: s.FDialSpecificServer}
 	s.master = &prodMaster{dial: s.FDialServer}
 	s.hack = &sync.Mutex{}
+	s.watchers = make(map[int]chan *pb.RipEvent)
+	s.watchLock = &sync.Mutex{}
+	s.store = &keystoreStore{server: s.GoServer}
+	s.saveLock = &sync.Mutex{}
+	s.history = &pb.History{Records: make(map[int32]*pb.RecordHistory)}
+	s.historyLock = &sync.Mutex{}
+	s.ripLock = &sync.Mutex{}
+	s.scanLock = &sync.Mutex{}
+	s.scanWorkers = 8
+	s.watchSettle = time.Second
+	s.watchQuiet = time.Second * 30
+	s.conversions = make(map[string]time.Time)
+	s.conversionLock = &sync.Mutex{}
+	s.manifest = &pb.Manifest{Files: make(map[string]*pb.FileChecksum)}
+	s.manifestLock = &sync.Mutex{}
+	s.scrub = &pb.ScrubProgress{}
 
 	return s
 }
 
+// save writes the config back, failing with Aborted if it has been saved since this copy was
+// loaded. The keystore has no compare and swap, so the stored revision is reread just ahead
+// of the write; nodes can only race in the gap between the two.
 func (s *Server) save(ctx context.Context, config *pb.Config) error {
-	return s.KSclient.Save(ctx, KEY, config)
+	s.saveLock.Lock()
+	defer s.saveLock.Unlock()
+
+	data, err := s.store.load(ctx, KEY, &pb.Config{})
+	if err != nil {
+		code := status.Convert(err).Code()
+		if code != codes.NotFound && code != codes.InvalidArgument {
+			return err
+		}
+	} else if stored := data.(*pb.Config); stored.GetRevision() != config.GetRevision() {
+		return status.Errorf(codes.Aborted, "config is at revision %v, we loaded %v", stored.GetRevision(), config.GetRevision())
+	}
+
+	config.Revision++
+	err = s.store.save(ctx, KEY, config)
+	if err != nil {
+		config.Revision--
+	}
+	return err
+}
+
+// updateConfig loads the config, applies change to it and saves it, starting over from a
+// fresh load whenever someone else saved first. change may run more than once, so it
+// should only edit the config; anything with side effects belongs before the call.
+func (s *Server) updateConfig(ctx context.Context, change func(config *pb.Config) error) error {
+	var err error
+	for i := 0; i < configRetries; i++ {
+		config, lerr := s.load(ctx)
+		if lerr != nil {
+			return lerr
+		}
+
+		err = change(config)
+		if err != nil {
+			return err
+		}
+
+		err = s.save(ctx, config)
+		if status.Convert(err).Code() != codes.Aborted {
+			return err
+		}
+		s.CtxLog(ctx, fmt.Sprintf("Config save lost a race, retrying: %v", err))
+	}
+	return err
 }
 
 func (s *Server) load(ctx context.Context) (*pb.Config, error) {
 	config := &pb.Config{}
-	data, _, err := s.KSclient.Read(ctx, KEY, config)
+	data, err := s.store.load(ctx, KEY, config)
 
 	if err != nil {
 		return nil, err
@@ -298,6 +416,25 @@ func (s *Server) load(ctx context.Context) (*pb.Config, error) {
 		config.GoalFolder = make(map[int32]int32)
 	}
 
+	if config.ToGoDetail == nil {
+		config.ToGoDetail = make(map[int32]*pb.QueueEntry)
+	}
+
+	if config.Outstanding == nil {
+		config.Outstanding = make(map[int32]*pb.Outstanding)
+	}
+
+	migrated, err := s.migrate(ctx, config)
+	if err != nil {
+		return nil, err
+	}
+	if migrated {
+		err = s.save(ctx, config)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	s.updateMetrics(ctx, config)
 	return config, nil
 }
@@ -328,7 +465,7 @@ func (s *Server) writeCount(ctx context.Context) error {
 	if err == nil {
 		defer conn.Close()
 		client := pbvs.NewVersionServerClient(conn)
-		client.SetVersion(ctx, &pbvs.SetVersionRequest{Set: &pbvs.Version{Key: "github.com.brotherlogic.cdprocessor", Value: int64(len(s.rips)), Setter: "cdprocessor"}})
+		client.SetVersion(ctx, &pbvs.SetVersionRequest{Set: &pbvs.Version{Key: "github.com.brotherlogic.cdprocessor", Value: int64(len(s.getRips())), Setter: "cdprocessor"}})
 	}
 	return err
 }
@@ -340,7 +477,7 @@ func (s *Server) GetState() []*pbg.State {
 
 func (s *Server) runVerify(ctx context.Context, config *pb.Config) error {
 	ids := []int32{}
-	for _, rip := range s.rips {
+	for _, rip := range s.getRips() {
 		err := s.verify(ctx, rip.Id, config)
 		st, ok := status.FromError(err)
 		if ok && st.Code() == codes.DataLoss {
@@ -357,7 +494,7 @@ func (s *Server) runVerify(ctx context.Context, config *pb.Config) error {
 
 func (s *Server) runLink(ctx context.Context, config *pb.Config) error {
 	s.count = int64(0)
-	for _, rip := range s.rips {
+	for _, rip := range s.getRips() {
 		err := s.makeLinks(ctx, rip.Id, false, config)
 		st := status.Convert(err)
 		if st.Code() != codes.ResourceExhausted && err != nil {
@@ -365,7 +502,7 @@ func (s *Server) runLink(ctx context.Context, config *pb.Config) error {
 		}
 		s.count++
 	}
-	return nil
+	return s.save(ctx, config)
 }
 
 func main() {
@@ -374,6 +511,14 @@ func main() {
 	var mp3dir = flag.String("mp3", "/home/simon/music/mp3s/", "Base directory for all mp3s location")
 	var flacdir = flag.String("flac", "/home/simon/music/flacs/", "Base directory for all flacs location")
 	var init = flag.Bool("init", false, "Prep server")
+	var storeType = flag.String("config_store", "keystore", "Where to keep the config: keystore or file")
+	var storeDir = flag.String("config_dir", "/home/simon/.cdprocessor/", "Directory for the file config store")
+	var scanWorkers = flag.Int("scan_workers", 8, "The number of rip directories to read at once")
+	var layouts layoutFlag
+	flag.Var(&layouts, "layout", "An extra rip directory layout as name=pattern, with id and optional disk and slug groups; may be repeated")
+	var rescan = flag.Duration("rescan", time.Hour, "How often to fully rescan the rips, on top of watching for changes")
+	var scrubRate = flag.Int64("scrub_rate", 20*1024*1024, "How many bytes a second the scrub reads, 0 for no limit")
+	var scrubInterval = flag.Duration("scrub_interval", time.Hour*24*7, "How long between scrubs of the rip library")
 	flag.Parse()
 
 	//Turn off logging
@@ -382,10 +527,19 @@ func main() {
 		log.SetOutput(ioutil.Discard)
 	}
 	server := Init(*dir, *mp3dir, *flacdir)
+	store, err := buildStore(server, *storeType, *storeDir)
+	if err != nil {
+		log.Fatalf("Unable to build store: %v", err)
+	}
+	server.store = store
+	server.scanWorkers = *scanWorkers
+	server.scrubRate = *scrubRate
+	server.layouts = append(layouts, defaultLayouts...)
+	server.io = &prodIo{dir: *dir, log: server.CtxLog, layouts: server.layouts}
 	server.PrepServer("cdprocessor")
 	server.Register = server
 
-	err := server.RegisterServerV2(false)
+	err = server.RegisterServerV2(false)
 	if err != nil {
 		return
 	}
@@ -404,10 +558,35 @@ func main() {
 
 		mapper := make(map[int32]int64)
 		mapper[1] = 1
-		err := server.KSclient.Save(ctx, KEY, &pb.Config{LastProcessTime: mapper})
+		err := server.save(ctx, &pb.Config{LastProcessTime: mapper, SchemaVersion: latestSchema()})
 		fmt.Printf("Inits: %v\n", err)
 		return
 	}
 
+	ctx, cancel = utils.ManualContext("cdprocessor-history", time.Minute)
+	err = server.loadHistory(ctx)
+	if err != nil {
+		log.Fatalf("Unable to load history: %v", err)
+	}
+	err = server.loadRipIndex(ctx)
+	if err != nil {
+		log.Fatalf("Unable to load rip index: %v", err)
+	}
+	err = server.loadManifest(ctx)
+	if err != nil {
+		log.Fatalf("Unable to load manifest: %v", err)
+	}
+	cancel()
+
+	go server.reconcileRips()
+	go server.watchRips()
+	go server.runRipScan(*rescan)
+
+	go server.runCompaction()
+	go server.runHistorySave()
+	go server.runAudit()
+	go server.runScrub(*scrubInterval)
+	go server.runDecodes()
+
 	server.Serve()
 }
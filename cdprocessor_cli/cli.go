@@ -5,12 +5,14 @@ import (
 	"log"
 	"os"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/brotherlogic/goserver/utils"
 
 	pbcdp "github.com/brotherlogic/cdprocessor/proto"
 	pbrc "github.com/brotherlogic/recordcollection/proto"
+	"google.golang.org/protobuf/encoding/protojson"
 
 	//Needed to pull in gzip encoding init
 	_ "google.golang.org/grpc/encoding/gzip"
@@ -34,8 +36,9 @@ func main() {
 		if err != nil {
 			log.Fatalf("Bad read: %v", err)
 		}
-		for i, entry := range resp.GetIds() {
-			fmt.Printf("%v. %v\n", i, entry)
+		for i, entry := range resp.GetRecords() {
+			fmt.Printf("%v. [%v/%v] %v - issue %v since %v: %v\n", i, entry.GetReleaseId(), entry.GetInstanceId(), entry.GetTitle(),
+				entry.GetIssue(), time.Unix(entry.GetOpened(), 0), entry.GetDetail())
 		}
 	case "sforce":
 		val, _ := strconv.ParseInt(os.Args[2], 10, 32)
@@ -45,43 +48,227 @@ func main() {
 		fmt.Printf("%v and %v\n", resp, err)
 	case "gforce":
 		val, _ := strconv.ParseInt(os.Args[2], 10, 32)
-		conn, err := utils.LFDialServer(ctx, "recordcollection")
+		resp, err := registry.BulkForce(ctx, &pbcdp.BulkForceRequest{Type: pbcdp.ForceRequest_RECREATE_LINKS, ReleaseId: int32(val)})
 		if err != nil {
-			log.Fatalf("Bad dial: %v", err)
+			log.Fatalf("Bad force: %v", err)
 		}
-		client := pbrc.NewRecordCollectionServiceClient(conn)
-		ids, err := client.QueryRecords(ctx, &pbrc.QueryRecordsRequest{Query: &pbrc.QueryRecordsRequest_ReleaseId{int32(val)}})
-		for _, id := range ids.GetInstanceIds() {
-			resp, err := registry.Force(ctx, &pbcdp.ForceRequest{Type: pbcdp.ForceRequest_RECREATE_LINKS, Id: int32(id)})
-			fmt.Printf("%v and %v\n", resp, err)
+		for _, result := range resp.GetResults() {
+			fmt.Printf("%v and %v\n", result.GetInstanceId(), result.GetError())
+		}
+	case "fforce":
+		val, _ := strconv.ParseInt(os.Args[2], 10, 32)
+		resp, err := registry.BulkForce(ctx, &pbcdp.BulkForceRequest{Type: pbcdp.ForceRequest_RECREATE_LINKS, FolderId: int32(val)})
+		if err != nil {
+			log.Fatalf("Bad force: %v", err)
+		}
+		for _, result := range resp.GetResults() {
+			fmt.Printf("%v and %v\n", result.GetInstanceId(), result.GetError())
 		}
 	case "force":
 		val, _ := strconv.ParseInt(os.Args[2], 10, 32)
-		resp, err := registry.Force(ctx, &pbcdp.ForceRequest{Type: pbcdp.ForceRequest_RECREATE_LINKS, Id: int32(val)})
+		ftype := pbcdp.ForceRequest_RECREATE_LINKS
+		if len(os.Args) > 3 {
+			tval, ok := pbcdp.ForceRequest_ForceType_value[os.Args[3]]
+			if !ok {
+				log.Fatalf("Unknown force type: %v", os.Args[3])
+			}
+			ftype = pbcdp.ForceRequest_ForceType(tval)
+		}
+		resp, err := registry.Force(ctx, &pbcdp.ForceRequest{Type: ftype, Id: int32(val)})
 		fmt.Printf("%v and %v\n", resp, err)
 	case "got":
-		resp, err := registry.GetRipped(ctx, &pbcdp.GetRippedRequest{})
+		i, _ := strconv.ParseInt(os.Args[2], 10, 32)
+		resp, err := registry.GetRipped(ctx, &pbcdp.GetRippedRequest{Id: int32(i)})
 
 		if err == nil {
 			fmt.Printf("Got %v ripped records\n", len(resp.GetRipped()))
-			i, _ := strconv.ParseInt(os.Args[2], 10, 32)
 			for _, missing := range resp.GetRipped() {
-				if missing.Id == int32(i) {
-					fmt.Printf("%v\n", missing)
-				}
+				fmt.Printf("%v\n", missing)
 			}
 		} else {
 			fmt.Printf("Error: %v\n", err)
 		}
 	case "missing":
-		resp, err := registry.GetMissing(ctx, &pbcdp.GetMissingRequest{})
+		req := &pbcdp.GetMissingRequest{}
+		if len(os.Args) > 2 {
+			val, _ := strconv.ParseInt(os.Args[2], 10, 32)
+			req.Max = int32(val)
+		}
+		resp, err := registry.GetMissing(ctx, req)
 
 		if err != nil {
 			log.Fatalf("Error in request: %v", err)
 		}
 
-		for i, missing := range resp.Missing {
-			fmt.Printf("%v. [%v] %v\n", i, missing.GetRelease().Id, missing.GetRelease().Title)
+		for i, missing := range resp.GetQueue() {
+			if len(missing.GetError()) > 0 {
+				fmt.Printf("%v. [%v] unable to look up: %v\n", i, missing.GetEntry().GetInstanceId(), missing.GetError())
+				continue
+			}
+			fmt.Printf("%v. [%v] %v - %v since %v (%v)\n", i, missing.GetRecord().GetRelease().Id, missing.GetRecord().GetRelease().Title,
+				missing.GetEntry().GetReason(), time.Unix(missing.GetEntry().GetEnqueueTime(), 0), missing.GetEntry().GetDetail())
+		}
+	case "status":
+		val, _ := strconv.ParseInt(os.Args[2], 10, 32)
+		resp, err := registry.GetRipStatus(ctx, &pbcdp.GetRipStatusRequest{InstanceId: int32(val)})
+		if err != nil {
+			log.Fatalf("Bad status: %v", err)
+		}
+		fmt.Printf("Goal folder %v, folder %v, formats %v\n", resp.GetGoalFolder(), resp.GetFolderId(), resp.GetFormats())
+		for _, disk := range resp.GetDisks() {
+			fmt.Printf("Disk %v\n", disk.GetDisk())
+			for _, track := range disk.GetTracks() {
+				fmt.Printf("  %v. %v [%v]\n", track.GetPosition(), track.GetTitle(), track.GetFormat())
+			}
+		}
+		for _, files := range [][]string{resp.GetRipFiles(), resp.GetMp3Files(), resp.GetFlacFiles()} {
+			for _, file := range files {
+				fmt.Printf("%v\n", file)
+			}
+		}
+		fmt.Printf("Issue %v, processed %v, ripped %v\n", resp.GetIssue(), time.Unix(resp.GetLastProcessTime(), 0), time.Unix(resp.GetLastRipTime(), 0))
+		fmt.Printf("Queued: %v %v\n", resp.GetInQueue(), resp.GetQueueEntry())
+	case "explain":
+		val, _ := strconv.ParseInt(os.Args[2], 10, 32)
+		resp, err := registry.ExplainLinks(ctx, &pbcdp.ExplainLinksRequest{InstanceId: int32(val), Force: len(os.Args) > 3 && os.Args[3] == "force"})
+		if err != nil {
+			log.Fatalf("Bad explain: %v", err)
+		}
+		for _, rule := range resp.GetRules() {
+			fmt.Printf("%v [%v]: %v\n", rule.GetName(), rule.GetFired(), rule.GetDetail())
+		}
+		if len(resp.GetFired()) > 0 {
+			fmt.Printf("Skipped by %v\n", resp.GetFired())
+		}
+		for i, action := range resp.GetActions() {
+			fmt.Printf("%v. %v\n", i, action)
+		}
+	case "enqueue":
+		val, _ := strconv.ParseInt(os.Args[2], 10, 32)
+		resp, err := registry.Enqueue(ctx, &pbcdp.EnqueueRequest{InstanceId: int32(val), Reason: strings.Join(os.Args[3:], " ")})
+		fmt.Printf("%v and %v\n", resp, err)
+	case "dequeue":
+		val, _ := strconv.ParseInt(os.Args[2], 10, 32)
+		resp, err := registry.Dequeue(ctx, &pbcdp.DequeueRequest{InstanceId: int32(val), Reason: strings.Join(os.Args[3:], " ")})
+		fmt.Printf("%v and %v\n", resp, err)
+	case "snooze":
+		val, _ := strconv.ParseInt(os.Args[2], 10, 32)
+		until, err := time.Parse("2006-01-02", os.Args[3])
+		if err != nil {
+			log.Fatalf("Bad date (want YYYY-MM-DD): %v", err)
+		}
+		resp, err := registry.Snooze(ctx, &pbcdp.SnoozeRequest{InstanceId: int32(val), Until: until.Unix(), Reason: strings.Join(os.Args[4:], " ")})
+		fmt.Printf("%v and %v\n", resp, err)
+	case "plan":
+		val, _ := strconv.ParseInt(os.Args[2], 10, 32)
+		resp, err := registry.GetTagPlan(ctx, &pbcdp.GetTagPlanRequest{InstanceId: int32(val)})
+		if err != nil {
+			log.Fatalf("Bad plan: %v", err)
+		}
+		for _, track := range resp.GetTracks() {
+			fmt.Printf("%v-%v: %v / %v / %v (disc %v) from %v [%v]\n", track.GetDisk(), track.GetPosition(), track.GetTitle(), track.GetArtist(), track.GetAlbum(), track.GetDiscNumber(), track.GetSource(), track.GetSourceExists())
+			for _, op := range track.GetOperations() {
+				fmt.Printf("  %v: %q\n", op.GetStage(), op.GetCommand())
+			}
+		}
+	case "compact":
+		resp, err := registry.CompactConfig(ctx, &pbcdp.CompactConfigRequest{DryRun: len(os.Args) > 2 && os.Args[2] == "dry"})
+		if err != nil {
+			log.Fatalf("Bad compact: %v", err)
+		}
+		fmt.Printf("Checked %v records\n", resp.GetReport().GetChecked())
+		for _, removed := range resp.GetReport().GetRemoved() {
+			fmt.Printf("%v: %v %v\n", removed.GetInstanceId(), removed.GetReason(), removed.GetFields())
+		}
+		for _, skipped := range resp.GetReport().GetSkipped() {
+			fmt.Printf("Skipped %v: %v\n", skipped.GetInstanceId(), skipped.GetReason())
+		}
+	case "export":
+		resp, err := registry.ExportConfig(ctx, &pbcdp.ExportConfigRequest{})
+		if err != nil {
+			log.Fatalf("Bad export: %v", err)
+		}
+		data, err := protojson.MarshalOptions{Multiline: true, Indent: "  "}.Marshal(resp.GetConfig())
+		if err != nil {
+			log.Fatalf("Bad marshal: %v", err)
+		}
+		fmt.Printf("%v\n", string(data))
+	case "import":
+		data, err := os.ReadFile(os.Args[2])
+		if err != nil {
+			log.Fatalf("Bad read: %v", err)
+		}
+		config := &pbcdp.Config{}
+		err = protojson.Unmarshal(data, config)
+		if err != nil {
+			log.Fatalf("Bad config in %v: %v", os.Args[2], err)
+		}
+		resp, err := registry.ImportConfig(ctx, &pbcdp.ImportConfigRequest{Config: config, DryRun: len(os.Args) > 3 && os.Args[3] == "dry"})
+		if err != nil {
+			log.Fatalf("Bad import: %v", err)
+		}
+		for _, line := range resp.GetDiff() {
+			fmt.Printf("%v\n", line)
+		}
+		fmt.Printf("%v changes\n", len(resp.GetDiff()))
+	case "audit":
+		resp, err := registry.AuditRips(ctx, &pbcdp.AuditRipsRequest{})
+		if err != nil {
+			log.Fatalf("Bad audit: %v", err)
+		}
+		fmt.Printf("Checked %v rip ids\n", resp.GetAudit().GetChecked())
+		for _, problem := range resp.GetAudit().GetProblems() {
+			fmt.Printf("%v %v: %v\n", problem.GetKind(), problem.GetPaths(), problem.GetDetail())
+		}
+		for _, skipped := range resp.GetAudit().GetSkipped() {
+			fmt.Printf("Skipped %v %v: %v\n", skipped.GetRipId(), skipped.GetPaths(), skipped.GetDetail())
+		}
+	case "scrub":
+		resp, err := registry.GetScrubProgress(ctx, &pbcdp.GetScrubProgressRequest{})
+		if err != nil {
+			log.Fatalf("Bad scrub: %v", err)
+		}
+		progress := resp.GetProgress()
+		fmt.Printf("Scrubbed %v/%v files (%v bytes) since %v, %v problems, on %v\n", progress.GetScrubbed(), progress.GetFiles(), progress.GetBytes(),
+			time.Unix(progress.GetStartTime(), 0), progress.GetProblems(), progress.GetCurrent())
+		fmt.Printf("Last finished %v in %v\n", time.Unix(progress.GetLastFinished(), 0), time.Duration(progress.GetLastDurationSeconds())*time.Second)
+		for _, problem := range resp.GetProblems() {
+			fmt.Printf("%v [%v]: %v\n", problem.GetPath(), problem.GetId(), problem.GetProblem())
+		}
+	case "history":
+		val, _ := strconv.ParseInt(os.Args[2], 10, 32)
+		req := &pbcdp.GetHistoryRequest{Id: int32(val)}
+		if len(os.Args) > 3 {
+			max, _ := strconv.ParseInt(os.Args[3], 10, 32)
+			req.Max = int32(max)
+		}
+		resp, err := registry.GetHistory(ctx, req)
+		if err != nil {
+			log.Fatalf("Bad history: %v", err)
+		}
+		for _, entry := range resp.GetEntries() {
+			fmt.Printf("%v %v [%v] %v", time.Unix(entry.GetTimestamp(), 0), entry.GetType(), entry.GetInstanceId(), entry.GetDetail())
+			if len(entry.GetError()) > 0 {
+				fmt.Printf(": %v", entry.GetError())
+			}
+			fmt.Printf("\n")
+		}
+	case "watch":
+		req := &pbcdp.WatchRipsRequest{}
+		if len(os.Args) > 2 {
+			val, _ := strconv.ParseInt(os.Args[2], 10, 32)
+			req.Id = int32(val)
+		}
+		stream, err := registry.WatchRips(ctx, req)
+		if err != nil {
+			log.Fatalf("Bad watch: %v", err)
+		}
+		for {
+			event, err := stream.Recv()
+			if err != nil {
+				log.Fatalf("Watch ended: %v", err)
+			}
+			fmt.Printf("%v %v [%v] %v\n", time.Unix(event.GetTimestamp(), 0), event.GetType(), event.GetId(), event)
 		}
 	default:
 		fmt.Printf("Unknown command: %v\n", os.Args[1])
@@ -1,11 +1,19 @@
 package main
 
 import (
+	"encoding/base64"
 	"fmt"
+	"io/ioutil"
 	"sort"
+	"strings"
+	"sync"
 	"time"
+	"unicode/utf8"
 
 	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 
 	pb "github.com/brotherlogic/cdprocessor/proto"
 	pbcdp "github.com/brotherlogic/cdprocessor/proto"
@@ -38,9 +46,80 @@ func (s *Server) updateMetrics(ctx context.Context, config *pb.Config) {
 	togo.Set(float64(len(config.GetToGo())))
 }
 
-// GetRipped returns the ripped cds
+func matchesRip(rip *pbcdp.Rip, req *pbcdp.GetRippedRequest, ids map[int32]bool) bool {
+	if len(ids) > 0 && !ids[rip.GetId()] {
+		return false
+	}
+
+	diskMatch := req.GetDisk() == 0
+	mp3Match := !req.GetMissingMp3()
+	flacMatch := !req.GetMissingFlac()
+	wavMatch := !req.GetWavOnly()
+	for _, t := range rip.GetTracks() {
+		if t.GetDisk() == req.GetDisk() {
+			diskMatch = true
+		}
+		if len(t.GetMp3Path()) == 0 {
+			mp3Match = true
+		}
+		if len(t.GetFlacPath()) == 0 {
+			flacMatch = true
+		}
+		if len(t.GetWavPath()) > 0 && len(t.GetMp3Path()) == 0 && len(t.GetFlacPath()) == 0 {
+			wavMatch = true
+		}
+	}
+
+	return diskMatch && mp3Match && flacMatch && wavMatch
+}
+
+// ripIds lists the rip ids a GetRipped filter covers; the id may be a release id or an instance
+// id, so we take the id itself along with the release of the instance if it is one
+func (s *Server) ripIds(ctx context.Context, id int32) map[int32]bool {
+	if id == 0 {
+		return nil
+	}
+
+	ids := map[int32]bool{id: true}
+	record, err := s.getter.getRecord(ctx, id)
+	if err != nil {
+		s.CtxLog(ctx, fmt.Sprintf("Unable to look up %v as an instance: %v", id, err))
+		return ids
+	}
+	ids[record.GetRelease().GetId()] = true
+	return ids
+}
+
+// GetRipped returns the ripped cds, ordered by path; a page token holds the path of the last
+// rip returned, so paging isn't thrown out by rips coming and going in between
 func (s *Server) GetRipped(ctx context.Context, req *pbcdp.GetRippedRequest) (*pbcdp.GetRippedResponse, error) {
-	return &pbcdp.GetRippedResponse{Ripped: s.rips}, nil
+	after := ""
+	if len(req.GetPageToken()) > 0 {
+		val, err := base64.RawURLEncoding.DecodeString(req.GetPageToken())
+		if err != nil || len(val) == 0 || !utf8.Valid(val) {
+			return nil, status.Errorf(codes.InvalidArgument, "Bad page token: %v", req.GetPageToken())
+		}
+		after = string(val)
+	}
+
+	ids := s.ripIds(ctx, req.GetId())
+	var rips []*pbcdp.Rip
+	for _, rip := range s.getRips() {
+		if rip.GetPath() > after && matchesRip(rip, req, ids) {
+			rips = append(rips, rip)
+		}
+	}
+	sort.Slice(rips, func(i, j int) bool {
+		return rips[i].GetPath() < rips[j].GetPath()
+	})
+
+	nextToken := ""
+	if req.GetPageSize() > 0 && len(rips) > int(req.GetPageSize()) {
+		rips = rips[:req.GetPageSize()]
+		nextToken = base64.RawURLEncoding.EncodeToString([]byte(rips[len(rips)-1].GetPath()))
+	}
+
+	return &pbcdp.GetRippedResponse{Ripped: rips, NextPageToken: nextToken}, nil
 }
 
 // GetMissing gets the missing rips
@@ -50,88 +129,500 @@ func (s *Server) GetMissing(ctx context.Context, req *pbcdp.GetMissingRequest) (
 		return nil, err
 	}
 
-	sort.Slice(config.ToGo, func(i, j int) bool {
-		return config.ToGo[i] < config.ToGo[j]
-	})
+	queue := orderQueue(config, req.GetIncludeSnoozed())
+	if req.GetMax() > 0 && len(queue) > int(req.GetMax()) {
+		queue = queue[:req.GetMax()]
+	}
 
-	var record *rcpb.Record
-	if len(config.ToGo) > 0 {
-		record, err = s.getter.getRecord(ctx, config.ToGo[0])
+	resp := &pbcdp.GetMissingResponse{}
+	for _, entry := range queue {
+		record, err := s.getter.getRecord(ctx, entry.GetInstanceId())
 		if err != nil {
-			return nil, err
+			s.CtxLog(ctx, fmt.Sprintf("Unable to get queued %v: %v", entry.GetInstanceId(), err))
+			resp.Queue = append(resp.Queue, &pbcdp.MissingRecord{Entry: entry, Error: err.Error()})
+			continue
+		}
+		resp.Missing = append(resp.Missing, record)
+		resp.Queue = append(resp.Queue, &pbcdp.MissingRecord{Record: record, Entry: entry})
+	}
+
+	return resp, nil
+}
+
+// orderQueue returns the rip queue, oldest entry first
+func orderQueue(config *pb.Config, includeSnoozed bool) []*pb.QueueEntry {
+	var queue []*pb.QueueEntry
+	for _, id := range config.GetToGo() {
+		entry, ok := config.GetToGoDetail()[id]
+		if !ok {
+			entry = &pb.QueueEntry{InstanceId: id}
+		}
+		if includeSnoozed || time.Now().Unix() >= entry.GetSnoozeUntil() {
+			queue = append(queue, entry)
+		}
+	}
+
+	sort.SliceStable(queue, func(i, j int) bool {
+		if queue[i].GetEnqueueTime() != queue[j].GetEnqueueTime() {
+			return queue[i].GetEnqueueTime() < queue[j].GetEnqueueTime()
+		}
+		return queue[i].GetInstanceId() < queue[j].GetInstanceId()
+	})
+
+	return queue
+}
+
+// addToQueue puts the record on the rip queue, returning its entry, or nil if
+// the record has been dequeued by hand
+func addToQueue(config *pb.Config, id int32) *pb.QueueEntry {
+	if _, ok := config.GetSuppressed()[id]; ok {
+		return nil
+	}
+
+	found := false
+	for _, togo := range config.ToGo {
+		if togo == id {
+			found = true
+		}
+	}
+	if !found {
+		config.ToGo = append(config.ToGo, id)
+	}
+
+	if config.ToGoDetail == nil {
+		config.ToGoDetail = make(map[int32]*pb.QueueEntry)
+	}
+	entry, ok := config.ToGoDetail[id]
+	if !ok {
+		entry = &pb.QueueEntry{InstanceId: id, EnqueueTime: time.Now().Unix()}
+		config.ToGoDetail[id] = entry
+	}
+	return entry
+}
+
+// removeFromQueue takes the record off the rip queue, reporting if it was there
+func removeFromQueue(config *pb.Config, id int32) bool {
+	found := false
+	var ntogo []int32
+	for _, togo := range config.ToGo {
+		if togo != id {
+			ntogo = append(ntogo, togo)
+		} else {
+			found = true
 		}
 	}
+	config.ToGo = ntogo
+	delete(config.ToGoDetail, id)
+	return found
+}
 
-	return &pbcdp.GetMissingResponse{Missing: []*rcpb.Record{record}}, nil
+// queueReason works out why a failed link run puts a record on the queue
+func queueReason(err error, config *pb.Config, id int32) pb.QueueEntry_Reason {
+	msg := status.Convert(err).Message()
+	switch {
+	case strings.HasPrefix(msg, missingTrack) && config.GetLastProcessTime()[id] == 0:
+		return pb.QueueEntry_NEVER_LINKED
+	case strings.HasPrefix(msg, missingTrack):
+		return pb.QueueEntry_MISSING_TRACK
+	case strings.HasPrefix(msg, fileCountMismatch):
+		return pb.QueueEntry_FILE_COUNT_MISMATCH
+	case strings.HasPrefix(msg, corruptOutput):
+		return pb.QueueEntry_CORRUPT_OUTPUT
+	}
+	return pb.QueueEntry_UNKNOWN
 }
 
 // Force the processor to do something
 func (s *Server) Force(ctx context.Context, req *pbcdp.ForceRequest) (*pbcdp.ForceResponse, error) {
+	// Relinking is the only force that changes the config
+	if req.GetType() == pbcdp.ForceRequest_RECREATE_LINKS {
+		s.hack.Lock()
+		defer s.hack.Unlock()
+	}
+
 	config, err := s.load(ctx)
 	if err != nil {
 		return nil, err
 	}
-	s.hack.Lock()
-	defer s.hack.Unlock()
+	before := proto.Clone(config).(*pb.Config)
+
+	// Whatever the force got done is kept, even if it failed part way
+	err = s.runForce(ctx, req, config)
+	if serr := s.saveLinkChanges(ctx, before, config); serr != nil {
+		return nil, serr
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &pbcdp.ForceResponse{}, nil
+}
+
+func (s *Server) runForce(ctx context.Context, req *pbcdp.ForceRequest, config *pb.Config) error {
 	switch req.Type {
 	case pbcdp.ForceRequest_RECREATE_LINKS:
-		return &pbcdp.ForceResponse{}, s.makeLinks(ctx, req.Id, true, config)
+		return s.makeLinks(ctx, req.Id, true, config)
+	case pbcdp.ForceRequest_RESCAN_DISK:
+		if req.GetId() == 0 {
+			return s.buildConfig(ctx)
+		}
+		fallthrough
+	case pbcdp.ForceRequest_RECONVERT_MP3, pbcdp.ForceRequest_RECONVERT_FLAC, pbcdp.ForceRequest_RETAG_ONLY, pbcdp.ForceRequest_VERIFY_ONLY:
+		record, err := s.getter.getRecord(ctx, req.Id)
+		if err != nil {
+			return err
+		}
+		s.addHistory(record.GetRelease().GetId(), record.GetRelease().GetInstanceId(), pb.HistoryEntry_FORCED, fmt.Sprintf("%v", req.Type), nil)
+
+		switch req.Type {
+		case pbcdp.ForceRequest_RECONVERT_MP3:
+			return s.reconvert(ctx, record.GetRelease().GetId(), false)
+		case pbcdp.ForceRequest_RECONVERT_FLAC:
+			return s.reconvert(ctx, record.GetRelease().GetId(), true)
+		case pbcdp.ForceRequest_RETAG_ONLY:
+			return s.retag(ctx, record, config)
+		case pbcdp.ForceRequest_VERIFY_ONLY:
+			return s.checkRecord(ctx, record)
+		case pbcdp.ForceRequest_RESCAN_DISK:
+			return s.rescanRip(ctx, record.GetRelease().GetId())
+		}
 	}
-	return nil, fmt.Errorf("Unknow force request")
+	return fmt.Errorf("Unknow force request")
 }
 
 // ClientUpdate on an updated record
 func (s *Server) ClientUpdate(ctx context.Context, req *rcpb.ClientUpdateRequest) (*rcpb.ClientUpdateResponse, error) {
 	//return &rcpb.ClientUpdateResponse{}, nil
+	s.hack.Lock()
+	defer s.hack.Unlock()
+
+	linked, err := s.load(ctx)
+	if err != nil {
+		return nil, err
+	}
+	before := proto.Clone(linked).(*pb.Config)
+	linkErr := s.makeLinks(ctx, req.GetInstanceId(), false, linked)
+
+	err = s.updateConfig(ctx, func(config *pb.Config) error {
+		replayLinkChanges(config, before, linked)
+		if linkErr == nil {
+			removeFromQueue(config, req.GetInstanceId())
+			delete(config.Suppressed, req.GetInstanceId())
+		} else if entry := addToQueue(config, req.GetInstanceId()); entry != nil {
+			entry.Reason = queueReason(linkErr, config, req.GetInstanceId())
+			entry.Detail = linkErr.Error()
+		} else {
+			s.CtxLog(ctx, fmt.Sprintf("Keeping %v off the queue: %v", req.GetInstanceId(), config.GetSuppressed()[req.GetInstanceId()].GetReason()))
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &rcpb.ClientUpdateResponse{}, linkErr
+}
+
+// GetOutstanding lists the records with open rip issues
+func (s *Server) GetOutstanding(ctx context.Context, req *pbcdp.GetOutstandingRequest) (*pbcdp.GetOutstandingResponse, error) {
 	config, err := s.load(ctx)
 	if err != nil {
 		return nil, err
 	}
-	s.hack.Lock()
-	defer s.hack.Unlock()
 
-	err = s.makeLinks(ctx, req.GetInstanceId(), false, config)
-	if err == nil {
-		var ntogo []int32
-		for _, togo := range config.ToGo {
-			if togo != req.GetInstanceId() {
-				ntogo = append(ntogo, togo)
-			}
+	var nums []int32
+	var records []*pbcdp.Outstanding
+	for id, issue := range config.GetIssueMapping() {
+		nums = append(nums, issue)
+
+		record, ok := config.GetOutstanding()[id]
+		if !ok {
+			record = &pbcdp.Outstanding{InstanceId: id}
+		}
+		record.Issue = issue
+		records = append(records, record)
+	}
+
+	sort.Slice(records, func(i, j int) bool {
+		if records[i].GetOpened() != records[j].GetOpened() {
+			return records[i].GetOpened() < records[j].GetOpened()
 		}
-		config.ToGo = ntogo
-		err = s.save(ctx, config)
+		return records[i].GetInstanceId() < records[j].GetInstanceId()
+	})
+
+	return &pbcdp.GetOutstandingResponse{Ids: nums, Records: records}, nil
+}
+
+func listFiles(dir string) []string {
+	var names []string
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return names
+	}
+	for _, f := range files {
+		names = append(names, dir+"/"+f.Name())
+	}
+	return names
+}
+
+// GetRipStatus pulls together everything we know about a single record
+func (s *Server) GetRipStatus(ctx context.Context, req *pbcdp.GetRipStatusRequest) (*pbcdp.GetRipStatusResponse, error) {
+	config, err := s.load(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	record, err := s.getter.getRecord(ctx, req.GetInstanceId())
+	if err != nil {
+		return nil, err
+	}
+	releaseID := record.GetRelease().GetId()
+
+	resp := &pbcdp.GetRipStatusResponse{
+		GoalFolder:      record.GetMetadata().GetGoalFolder(),
+		FolderId:        record.GetRelease().GetFolderId(),
+		Issue:           config.GetIssueMapping()[req.GetInstanceId()],
+		LastProcessTime: config.GetLastProcessTime()[req.GetInstanceId()],
+		LastRipTime:     config.GetLastRipTime()[req.GetInstanceId()],
+	}
+
+	for _, format := range record.GetRelease().GetFormats() {
+		resp.Formats = append(resp.Formats, format.GetName())
+	}
+
+	for _, track := range TrackExtract(record.GetRelease(), record.GetMetadata().GetGoalFolder() == 565206) {
+		if len(resp.Disks) == 0 || resp.Disks[len(resp.Disks)-1].GetDisk() != track.Disk {
+			resp.Disks = append(resp.Disks, &pbcdp.ExpectedDisk{Disk: track.Disk})
+		}
+		disk := resp.Disks[len(resp.Disks)-1]
+		disk.Tracks = append(disk.Tracks, &pbcdp.ExpectedTrack{Position: track.Position, Title: GetTitle(track), Format: track.Format})
+	}
+
+	for _, rip := range s.getRips() {
+		if rip.GetId() == releaseID || rip.GetId() == req.GetInstanceId() {
+			resp.Rips = append(resp.Rips, rip)
+			resp.RipFiles = append(resp.RipFiles, listFiles(s.dir+rip.GetPath())...)
+		}
+	}
+	resp.Mp3Files = listFiles(fmt.Sprintf("%v%v", s.mp3dir, releaseID))
+	resp.FlacFiles = listFiles(fmt.Sprintf("%v%v", s.flacdir, releaseID))
+
+	for _, id := range config.GetToGo() {
+		if id == req.GetInstanceId() {
+			resp.InQueue = true
+			resp.QueueEntry = config.GetToGoDetail()[id]
+		}
+	}
+
+	return resp, nil
+}
+
+// ExplainLinks describes what makeLinks would do with a record, without side effects
+func (s *Server) ExplainLinks(ctx context.Context, req *pbcdp.ExplainLinksRequest) (*pbcdp.ExplainLinksResponse, error) {
+	config, err := s.load(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	record, err := s.getter.getRecord(ctx, req.GetInstanceId())
+	if err != nil {
+		return nil, err
+	}
+
+	return s.explainLinks(record, req.GetForce(), config), nil
+}
+
+func (s *Server) resolveBulk(ctx context.Context, req *pbcdp.BulkForceRequest) ([]int32, error) {
+	ids := append([]int32{}, req.GetInstanceIds()...)
+
+	if req.GetReleaseId() > 0 {
+		rids, err := s.rc.getInstanceIds(ctx, req.GetReleaseId())
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, rids...)
+	}
+
+	if req.GetFolderId() > 0 {
+		records, err := s.rc.getRecordsInFolder(ctx, req.GetFolderId())
 		if err != nil {
 			return nil, err
 		}
-	} else {
+		for _, record := range records {
+			ids = append(ids, record.GetRelease().GetInstanceId())
+		}
+	}
+
+	seen := make(map[int32]bool)
+	var result []int32
+	for _, id := range ids {
+		if !seen[id] {
+			seen[id] = true
+			result = append(result, id)
+		}
+	}
+	return result, nil
+}
+
+// BulkForce runs a force over a set of records
+func (s *Server) BulkForce(ctx context.Context, req *pbcdp.BulkForceRequest) (*pbcdp.BulkForceResponse, error) {
+	ids, err := s.resolveBulk(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if len(ids) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "No records selected by %v", req)
+	}
+
+	ftype := req.GetType()
+	if ftype == pbcdp.ForceRequest_UNKNOWN {
+		ftype = pbcdp.ForceRequest_RECREATE_LINKS
+	}
+
+	concurrency := int(req.GetConcurrency())
+	if concurrency <= 0 {
+		concurrency = 4
+	}
+
+	results := make([]*pbcdp.ForceResult, len(ids))
+	sem := make(chan bool, concurrency)
+	wg := &sync.WaitGroup{}
+	for i, id := range ids {
+		results[i] = &pbcdp.ForceResult{InstanceId: id}
+
+		wg.Add(1)
+		sem <- true
+		go func(result *pbcdp.ForceResult) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			// Anything not yet started is dropped once the caller gives up
+			if ctx.Err() != nil {
+				result.Error = status.FromContextError(ctx.Err()).Err().Error()
+				return
+			}
+
+			_, err := s.Force(ctx, &pbcdp.ForceRequest{Type: ftype, Id: result.GetInstanceId()})
+			if err != nil {
+				result.Error = err.Error()
+			}
+		}(results[i])
+	}
+	wg.Wait()
+
+	return &pbcdp.BulkForceResponse{Results: results}, nil
+}
+
+// Enqueue puts a record on the rip queue by hand
+func (s *Server) Enqueue(ctx context.Context, req *pbcdp.EnqueueRequest) (*pbcdp.EnqueueResponse, error) {
+	if req.GetInstanceId() <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "bad instance id %v", req.GetInstanceId())
+	}
+	_, err := s.getter.getRecord(ctx, req.GetInstanceId())
+	if err != nil {
+		return nil, err
+	}
+
+	var entry *pbcdp.QueueEntry
+	err = s.updateConfig(ctx, func(config *pb.Config) error {
+		delete(config.Suppressed, req.GetInstanceId())
+		entry = addToQueue(config, req.GetInstanceId())
+		entry.Reason = pbcdp.QueueEntry_MANUAL
+		entry.Detail = req.GetReason()
+		entry.SnoozeUntil = 0
+		entry.SnoozeReason = ""
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pbcdp.EnqueueResponse{Entry: entry}, nil
+}
+
+// Dequeue takes a record off the rip queue by hand, and keeps it off until it is
+// enqueued again or links cleanly
+func (s *Server) Dequeue(ctx context.Context, req *pbcdp.DequeueRequest) (*pbcdp.DequeueResponse, error) {
+	err := s.updateConfig(ctx, func(config *pb.Config) error {
+		if !removeFromQueue(config, req.GetInstanceId()) {
+			return status.Errorf(codes.NotFound, "%v is not in the queue", req.GetInstanceId())
+		}
+		if config.Suppressed == nil {
+			config.Suppressed = make(map[int32]*pb.QueueSuppression)
+		}
+		config.Suppressed[req.GetInstanceId()] = &pb.QueueSuppression{InstanceId: req.GetInstanceId(), Reason: req.GetReason(), Time: time.Now().Unix()}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.CtxLog(ctx, fmt.Sprintf("Dequeued %v: %v", req.GetInstanceId(), req.GetReason()))
+	return &pbcdp.DequeueResponse{}, nil
+}
+
+// Snooze hides a queued record from GetMissing until the given time
+func (s *Server) Snooze(ctx context.Context, req *pbcdp.SnoozeRequest) (*pbcdp.SnoozeResponse, error) {
+	var entry *pbcdp.QueueEntry
+	err := s.updateConfig(ctx, func(config *pb.Config) error {
 		found := false
-		for _, togo := range config.ToGo {
+		for _, togo := range config.GetToGo() {
 			if togo == req.GetInstanceId() {
 				found = true
 			}
 		}
 		if !found {
-			config.ToGo = append(config.ToGo, req.GetInstanceId())
-			serr := s.save(ctx, config)
-			if serr != nil {
-				return nil, serr
-			}
+			return status.Errorf(codes.NotFound, "%v is not in the queue", req.GetInstanceId())
 		}
+
+		entry = addToQueue(config, req.GetInstanceId())
+		entry.SnoozeUntil = req.GetUntil()
+		entry.SnoozeReason = req.GetReason()
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	return &rcpb.ClientUpdateResponse{}, err
+	return &pbcdp.SnoozeResponse{Entry: entry}, nil
 }
 
-func (s *Server) GetOutstanding(ctx context.Context, req *pbcdp.GetOutstandingRequest) (*pbcdp.GetOutstandingResponse, error) {
-	config, err := s.load(ctx)
+// GetTagPlan lists the file operations buildLink would run for a record, without running them
+func (s *Server) GetTagPlan(ctx context.Context, req *pbcdp.GetTagPlanRequest) (*pbcdp.GetTagPlanResponse, error) {
+	record, err := s.getter.getRecord(ctx, req.GetInstanceId())
 	if err != nil {
 		return nil, err
 	}
 
-	var nums []int32
-	for _, issue := range config.GetIssueMapping() {
-		nums = append(nums, issue)
+	resp := &pbcdp.GetTagPlanResponse{}
+	trackSet := TrackExtract(record.GetRelease(), record.GetMetadata().GetGoalFolder() == 565206)
+	for _, track := range rippableTracks(trackSet) {
+		files := s.trackFiles(track, record)
+		plan := &pbcdp.TrackPlan{
+			Disk:         track.Disk,
+			Position:     track.Position,
+			Title:        GetTitle(track),
+			Artist:       computeArtist(record.GetRelease()),
+			Album:        record.GetRelease().GetTitle(),
+			DiscNumber:   prepend(track.Disk),
+			Source:       files.oldflac,
+			SourceExists: s.fileExists(files.oldflac),
+			Mp3Target:    files.newmp3,
+			FlacTarget:   files.newflac,
+		}
+		for _, command := range s.linkCommands(track, record) {
+			plan.Operations = append(plan.Operations, &pbcdp.FileOperation{Stage: command.stage, Command: command.command})
+		}
+		resp.Tracks = append(resp.Tracks, plan)
 	}
 
-	return &pbcdp.GetOutstandingResponse{Ids: nums}, nil
+	return resp, nil
+}
+
+// CompactConfig removes config entries for records which have left the collection
+func (s *Server) CompactConfig(ctx context.Context, req *pbcdp.CompactConfigRequest) (*pbcdp.CompactConfigResponse, error) {
+	report, err := s.compactConfig(ctx, req.GetDryRun())
+	if err != nil {
+		return nil, err
+	}
+	return &pbcdp.CompactConfigResponse{Report: report}, nil
 }
@@ -7,7 +7,12 @@ import (
 	"os"
 	"strconv"
 	"strings"
+	"sync"
 	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	pbcdp "github.com/brotherlogic/cdprocessor/proto"
 	pbgd "github.com/brotherlogic/godiscogs/proto"
@@ -28,7 +33,8 @@ func (gh *testGh) recordMissing(r *pbrc.Record) error {
 }
 
 type testRc struct {
-	failGet bool
+	failGet   bool
+	instances map[int32][]int32
 }
 
 func (rc *testRc) getRecordsInFolder(ctx context.Context, folder int32) ([]*pbrc.Record, error) {
@@ -36,15 +42,30 @@ func (rc *testRc) getRecordsInFolder(ctx context.Context, folder int32) ([]*pbrc
 		return nil, fmt.Errorf("Built to fail")
 	}
 	return []*pbrc.Record{
-		&pbrc.Record{Release: &pbgd.Release{Id: 12345, Formats: []*pbgd.Format{&pbgd.Format{Name: "CD"}}}},
-		&pbrc.Record{Release: &pbgd.Release{Id: 12346, Formats: []*pbgd.Format{&pbgd.Format{Name: "CD"}}}},
+		&pbrc.Record{Release: &pbgd.Release{Id: 12345, InstanceId: 12345, Formats: []*pbgd.Format{&pbgd.Format{Name: "CD"}}}},
+		&pbrc.Record{Release: &pbgd.Release{Id: 12346, InstanceId: 12346, Formats: []*pbgd.Format{&pbgd.Format{Name: "CD"}}}},
 	}, nil
 }
 
+func (rc *testRc) getInstanceIds(ctx context.Context, releaseID int32) ([]int32, error) {
+	if rc.failGet {
+		return nil, fmt.Errorf("Built to fail")
+	}
+	if rc.instances != nil {
+		return rc.instances[releaseID], nil
+	}
+	return []int32{releaseID, releaseID + 1}, nil
+}
+
 type testIo struct {
 	dir      string
 	failRead bool
 	failConv bool
+
+	// Outputs which fail to decode or can't be read, and those we've been asked to remove
+	corrupt    map[string]string
+	unreadable map[string]string
+	removed    []string
 }
 
 func (i *testIo) readDir() ([]os.FileInfo, error) {
@@ -59,6 +80,29 @@ func (i *testIo) readSubdir(f string) ([]os.FileInfo, error) {
 	return ioutil.ReadDir(i.dir + f)
 }
 
+func (i *testIo) stat(path string) (os.FileInfo, error) {
+	return os.Stat(i.dir + path)
+}
+
+func (i *testIo) audioInfo(path, format string, info os.FileInfo) *pbcdp.AudioInfo {
+	return readAudioInfo(i.dir+path, format, info)
+}
+
+func (i *testIo) checkAudio(path, format string) error {
+	if reason, ok := i.corrupt[path]; ok {
+		return &decodeError{err: fmt.Errorf("%v", reason)}
+	}
+	if reason, ok := i.unreadable[path]; ok {
+		return fmt.Errorf("%v", reason)
+	}
+	return nil
+}
+
+func (i *testIo) remove(path string) error {
+	i.removed = append(i.removed, path)
+	return nil
+}
+
 func (i *testIo) convert(name string) (int32, int32, error) {
 	if i.failConv {
 		return -1, -1, fmt.Errorf("Build to fail")
@@ -136,3 +180,524 @@ func TestUnkownForce(t *testing.T) {
 		t.Errorf("Empty force should have failed")
 	}
 }
+
+func TestGetRippedFilter(t *testing.T) {
+	s := InitTestServer("testdata/")
+
+	ripped, err := s.GetRipped(context.Background(), &pbcdp.GetRippedRequest{Id: 12345, WavOnly: true, MissingFlac: true})
+	if err != nil || len(ripped.GetRipped()) != 1 {
+		t.Errorf("Bad filter: %v -> %v", ripped, err)
+	}
+
+	ripped, err = s.GetRipped(context.Background(), &pbcdp.GetRippedRequest{Id: 12346})
+	if err != nil || len(ripped.GetRipped()) != 0 {
+		t.Errorf("Bad id filter: %v -> %v", ripped, err)
+	}
+
+	ripped, err = s.GetRipped(context.Background(), &pbcdp.GetRippedRequest{Disk: 2})
+	if err != nil || len(ripped.GetRipped()) != 0 {
+		t.Errorf("Bad disk filter: %v -> %v", ripped, err)
+	}
+
+	// Instance ids are resolved to their release
+	s.getter = &testGetter{records: map[int32]*pbrc.Record{999: {Release: &pbgd.Release{Id: 12345, InstanceId: 999}}}}
+	ripped, err = s.GetRipped(context.Background(), &pbcdp.GetRippedRequest{Id: 999})
+	if err != nil || len(ripped.GetRipped()) != 1 || ripped.GetRipped()[0].GetId() != 12345 {
+		t.Errorf("Bad instance filter: %v -> %v", ripped, err)
+	}
+
+	// Anything the getter can't find is taken as a release id
+	s.getter = &testGetter{missing: map[int32]bool{12345: true}}
+	ripped, err = s.GetRipped(context.Background(), &pbcdp.GetRippedRequest{Id: 12345})
+	if err != nil || len(ripped.GetRipped()) != 1 {
+		t.Errorf("Bad release filter: %v -> %v", ripped, err)
+	}
+}
+
+func TestGetRippedPaging(t *testing.T) {
+	s := InitTestServer("testdata/")
+	s.rips = []*pbcdp.Rip{&pbcdp.Rip{Id: 3, Path: "3"}, &pbcdp.Rip{Id: 1, Path: "1"}, &pbcdp.Rip{Id: 2, Path: "2"}, &pbcdp.Rip{Id: 4, Path: "4"}}
+
+	var ids []int32
+	token := ""
+	for {
+		ripped, err := s.GetRipped(context.Background(), &pbcdp.GetRippedRequest{PageSize: 2, PageToken: token})
+		if err != nil {
+			t.Fatalf("Bad page read: %v", err)
+		}
+		for _, rip := range ripped.GetRipped() {
+			ids = append(ids, rip.GetId())
+		}
+		token = ripped.GetNextPageToken()
+		if len(token) == 0 {
+			break
+		}
+
+		// The last rip we saw going away doesn't lose our place
+		if len(ids) == 2 {
+			s.setRips([]*pbcdp.Rip{&pbcdp.Rip{Id: 1, Path: "1"}, &pbcdp.Rip{Id: 3, Path: "3"}, &pbcdp.Rip{Id: 4, Path: "4"}})
+		}
+	}
+
+	if len(ids) != 4 || ids[0] != 1 || ids[1] != 2 || ids[2] != 3 || ids[3] != 4 {
+		t.Errorf("Bad paging: %v", ids)
+	}
+
+	_, err := s.GetRipped(context.Background(), &pbcdp.GetRippedRequest{PageToken: "blah"})
+	if err == nil {
+		t.Errorf("Bad token did not fail")
+	}
+}
+
+func TestGetMissingQueue(t *testing.T) {
+	s := InitTestServer("testdata/")
+	s.save(context.Background(), &pbcdp.Config{
+		ToGo: []int32{3, 2, 1},
+		ToGoDetail: map[int32]*pbcdp.QueueEntry{
+			1: &pbcdp.QueueEntry{InstanceId: 1, EnqueueTime: 30, Reason: pbcdp.QueueEntry_MISSING_TRACK},
+			2: &pbcdp.QueueEntry{InstanceId: 2, EnqueueTime: 20, Reason: pbcdp.QueueEntry_NEVER_LINKED},
+		},
+	})
+
+	missing, err := s.GetMissing(context.Background(), &pbcdp.GetMissingRequest{})
+	if err != nil {
+		t.Fatalf("Bad missing: %v", err)
+	}
+
+	if len(missing.GetQueue()) != 3 || missing.GetQueue()[0].GetEntry().GetInstanceId() != 3 || missing.GetQueue()[1].GetEntry().GetReason() != pbcdp.QueueEntry_NEVER_LINKED {
+		t.Errorf("Bad queue: %v", missing.GetQueue())
+	}
+
+	missing, err = s.GetMissing(context.Background(), &pbcdp.GetMissingRequest{Max: 1})
+	if err != nil || len(missing.GetMissing()) != 1 {
+		t.Errorf("Bad limited queue: %v -> %v", missing, err)
+	}
+}
+
+func TestGetMissingSkipsFailedLookups(t *testing.T) {
+	s := InitTestServer("testdata/")
+	s.getter = &testGetter{missing: map[int32]bool{2: true}}
+	s.save(context.Background(), &pbcdp.Config{ToGo: []int32{1, 2, 3}})
+
+	missing, err := s.GetMissing(context.Background(), &pbcdp.GetMissingRequest{})
+	if err != nil {
+		t.Fatalf("Bad missing: %v", err)
+	}
+	if len(missing.GetMissing()) != 2 || len(missing.GetQueue()) != 3 {
+		t.Fatalf("Bad missing: %v", missing)
+	}
+	if missing.GetQueue()[1].GetRecord() != nil || len(missing.GetQueue()[1].GetError()) == 0 {
+		t.Errorf("Failed lookup not reported: %v", missing.GetQueue()[1])
+	}
+}
+
+func TestGetMissingEmpty(t *testing.T) {
+	s := InitTestServer("testdata/")
+	s.save(context.Background(), &pbcdp.Config{})
+
+	missing, err := s.GetMissing(context.Background(), &pbcdp.GetMissingRequest{})
+	if err != nil || len(missing.GetMissing()) != 0 {
+		t.Errorf("Empty queue returned records: %v -> %v", missing, err)
+	}
+
+	if err := s.logMissing(context.Background()); err != nil {
+		t.Errorf("Bad log: %v", err)
+	}
+}
+
+func TestClientUpdateQueues(t *testing.T) {
+	s := InitTestServer("testdata/")
+	s.save(context.Background(), &pbcdp.Config{})
+
+	_, err := s.ClientUpdate(context.Background(), &pbrc.ClientUpdateRequest{InstanceId: 1234})
+	if err == nil {
+		t.Fatalf("Missing rip did not fail")
+	}
+
+	config, err := s.load(context.Background())
+	if err != nil {
+		t.Fatalf("Bad load: %v", err)
+	}
+
+	if len(config.GetToGo()) != 1 || config.GetToGoDetail()[1234].GetReason() != pbcdp.QueueEntry_NEVER_LINKED || config.GetToGoDetail()[1234].GetEnqueueTime() == 0 {
+		t.Errorf("Bad queue entry: %v", config)
+	}
+}
+
+func TestDequeueSuppresses(t *testing.T) {
+	s := InitTestServer("testdata/")
+	s.save(context.Background(), &pbcdp.Config{})
+
+	s.ClientUpdate(context.Background(), &pbrc.ClientUpdateRequest{InstanceId: 1234})
+	if _, err := s.Dequeue(context.Background(), &pbcdp.DequeueRequest{InstanceId: 1234, Reason: "Never ripping this"}); err != nil {
+		t.Fatalf("Bad dequeue: %v", err)
+	}
+
+	s.ClientUpdate(context.Background(), &pbrc.ClientUpdateRequest{InstanceId: 1234})
+	config, _ := s.load(context.Background())
+	if len(config.GetToGo()) != 0 || config.GetSuppressed()[1234].GetReason() != "Never ripping this" {
+		t.Errorf("Dequeued record came back: %v", config)
+	}
+
+	if _, err := s.Enqueue(context.Background(), &pbcdp.EnqueueRequest{InstanceId: 1234}); err != nil {
+		t.Fatalf("Bad enqueue: %v", err)
+	}
+	config, _ = s.load(context.Background())
+	if len(config.GetToGo()) != 1 || len(config.GetSuppressed()) != 0 {
+		t.Errorf("Enqueue did not lift the suppression: %v", config)
+	}
+}
+
+func TestEnqueueValidates(t *testing.T) {
+	s := InitTestServer("testdata/")
+	s.save(context.Background(), &pbcdp.Config{})
+	s.getter = &testGetter{missing: map[int32]bool{12: true}}
+
+	if _, err := s.Enqueue(context.Background(), &pbcdp.EnqueueRequest{InstanceId: 0}); status.Convert(err).Code() != codes.InvalidArgument {
+		t.Errorf("Bad id was enqueued: %v", err)
+	}
+	if _, err := s.Enqueue(context.Background(), &pbcdp.EnqueueRequest{InstanceId: 12}); status.Convert(err).Code() != codes.NotFound {
+		t.Errorf("Unknown record was enqueued: %v", err)
+	}
+
+	config, _ := s.load(context.Background())
+	if len(config.GetToGo()) != 0 {
+		t.Errorf("Queue changed: %v", config)
+	}
+}
+
+func TestForceRetag(t *testing.T) {
+	s := InitTestServer("testdata/")
+	s.save(context.Background(), &pbcdp.Config{})
+	s.forceCheck = true
+	tr := &testRipper{}
+	s.ripper = tr
+
+	_, err := s.Force(context.Background(), &pbcdp.ForceRequest{Type: pbcdp.ForceRequest_RETAG_ONLY, Id: 12345})
+	if err != nil {
+		t.Fatalf("Bad retag: %v", err)
+	}
+
+	if len(tr.commands) == 0 {
+		t.Fatalf("No tagging was run")
+	}
+	for _, command := range tr.commands {
+		if command[0] == "ln" || command[0] == "wget" {
+			t.Errorf("Retag ran %v", command)
+		}
+	}
+}
+
+func TestForceReconvert(t *testing.T) {
+	s := InitTestServer("testdata/")
+	s.save(context.Background(), &pbcdp.Config{})
+
+	tio := s.io.(*testIo)
+
+	_, err := s.Force(context.Background(), &pbcdp.ForceRequest{Type: pbcdp.ForceRequest_RECONVERT_MP3, Id: 12345})
+	if err != nil || s.ripCount != 3 {
+		t.Errorf("Bad reconvert (%v): %v", s.ripCount, err)
+	}
+
+	var mp3s []string
+	for _, track := range s.getRips()[0].GetTracks() {
+		if len(track.GetMp3Path()) > 0 {
+			mp3s = append(mp3s, track.GetMp3Path())
+		}
+	}
+	if len(mp3s) == 0 || len(tio.removed) != len(mp3s) {
+		t.Errorf("Old mp3s %v were not removed: %v", mp3s, tio.removed)
+	}
+	for i := range mp3s {
+		if tio.removed[i] != mp3s[i] {
+			t.Errorf("Removed %v, expected %v", tio.removed, mp3s)
+		}
+	}
+
+	_, err = s.Force(context.Background(), &pbcdp.ForceRequest{Type: pbcdp.ForceRequest_RECONVERT_FLAC, Id: 1234})
+	if err == nil || s.flacCount != 0 {
+		t.Errorf("Reconvert of missing rip did not fail (%v)", s.flacCount)
+	}
+}
+
+func TestForceRescan(t *testing.T) {
+	s := InitTestServer("testdata/")
+	s.save(context.Background(), &pbcdp.Config{})
+	s.rips = []*pbcdp.Rip{}
+
+	_, err := s.Force(context.Background(), &pbcdp.ForceRequest{Type: pbcdp.ForceRequest_RESCAN_DISK})
+	if err != nil || len(s.rips) != 1 {
+		t.Errorf("Bad rescan: %v -> %v", s.rips, err)
+	}
+}
+
+func TestForceRescanRip(t *testing.T) {
+	s := InitTestServer("testdata/")
+	s.save(context.Background(), &pbcdp.Config{})
+	s.setRips(append(s.getRips(), &pbcdp.Rip{Id: 99, Path: "99"}))
+
+	_, err := s.Force(context.Background(), &pbcdp.ForceRequest{Type: pbcdp.ForceRequest_RESCAN_DISK, Id: 99})
+	if err != nil || len(s.getRips()) != 1 {
+		t.Errorf("Bad rescan: %v -> %v", s.getRips(), err)
+	}
+
+	_, err = s.Force(context.Background(), &pbcdp.ForceRequest{Type: pbcdp.ForceRequest_RESCAN_DISK, Id: 98})
+	if status.Convert(err).Code() != codes.NotFound {
+		t.Errorf("Rescan of unknown rip did not fail: %v", err)
+	}
+}
+
+func TestForceVerifyIsReadOnly(t *testing.T) {
+	s := InitTestServer("testdata/")
+	s.save(context.Background(), &pbcdp.Config{})
+	_, err := s.Force(context.Background(), &pbcdp.ForceRequest{Type: pbcdp.ForceRequest_VERIFY_ONLY, Id: 12345})
+	if status.Convert(err).Code() != codes.DataLoss {
+		t.Errorf("Short rip verified: %v", err)
+	}
+
+	config, _ := s.load(context.Background())
+	if s.ripCount != 0 || s.flacCount != 0 || len(config.GetIssueMapping()) != 0 {
+		t.Errorf("Verify changed things: %v rips, %v flacs, %v", s.ripCount, s.flacCount, config)
+	}
+}
+
+func TestGetRipStatus(t *testing.T) {
+	s := InitTestServer("testdata/")
+	s.save(context.Background(), &pbcdp.Config{
+		IssueMapping: map[int32]int32{12345: 10},
+		ToGo:         []int32{12345},
+	})
+
+	status, err := s.GetRipStatus(context.Background(), &pbcdp.GetRipStatusRequest{InstanceId: 12345})
+	if err != nil {
+		t.Fatalf("Bad status: %v", err)
+	}
+
+	if status.GetIssue() != 10 || !status.GetInQueue() || len(status.GetRips()) != 1 || len(status.GetRipFiles()) != 6 || len(status.GetDisks()) != 1 {
+		t.Errorf("Bad status: %v", status)
+	}
+}
+
+func TestGetRipStatusFail(t *testing.T) {
+	s := InitTestServer("testdata/")
+	s.save(context.Background(), &pbcdp.Config{})
+	s.getter = &testGetter{fail: true}
+
+	status, err := s.GetRipStatus(context.Background(), &pbcdp.GetRipStatusRequest{InstanceId: 12345})
+	if err == nil {
+		t.Errorf("Bad getter did not fail: %v", status)
+	}
+}
+
+func TestBulkForce(t *testing.T) {
+	s := InitTestServer("testdata/")
+	s.save(context.Background(), &pbcdp.Config{})
+	// Rips whose directories have gone, which a rescan of each should drop
+	s.setRips(append(s.getRips(), &pbcdp.Rip{Id: 1, Path: "1"}, &pbcdp.Rip{Id: 12346, Path: "12346"}))
+
+	resp, err := s.BulkForce(context.Background(), &pbcdp.BulkForceRequest{Type: pbcdp.ForceRequest_RESCAN_DISK, ReleaseId: 12345, FolderId: 12, InstanceIds: []int32{1}})
+	if err != nil {
+		t.Fatalf("Bad force: %v", err)
+	}
+
+	if len(resp.GetResults()) != 3 || resp.GetResults()[0].GetInstanceId() != 1 || resp.GetResults()[2].GetInstanceId() != 12346 {
+		t.Errorf("Bad results: %v", resp)
+	}
+
+	for _, result := range resp.GetResults() {
+		if len(result.GetError()) > 0 {
+			t.Errorf("Force failed: %v", result)
+		}
+	}
+
+	if len(s.getRips()) != 1 || s.getRips()[0].GetId() != 12345 {
+		t.Errorf("Rips were not rescanned: %v", s.getRips())
+	}
+}
+
+// slowRipper takes a while over each mp3, tracking how many it's asked for at once
+type slowRipper struct {
+	testRipper
+	lock    sync.Mutex
+	running int
+	most    int
+}
+
+func (r *slowRipper) ripToMp3(ctx context.Context, pathIn, pathOut string) {
+	r.lock.Lock()
+	r.running++
+	if r.running > r.most {
+		r.most = r.running
+	}
+	r.lock.Unlock()
+
+	time.Sleep(time.Millisecond * 50)
+
+	r.lock.Lock()
+	r.running--
+	r.lock.Unlock()
+}
+
+func TestBulkForceConcurrency(t *testing.T) {
+	s := InitTestServer(t.TempDir() + "/")
+	s.save(context.Background(), &pbcdp.Config{})
+	ripper := &slowRipper{}
+	s.ripper = ripper
+	s.setWatching(true)
+
+	var rips []*pbcdp.Rip
+	for id := int32(1); id <= 6; id++ {
+		path := fmt.Sprintf("%v", id)
+		rips = append(rips, &pbcdp.Rip{Id: id, Path: path, Tracks: []*pbcdp.Track{{Disk: 1, TrackNumber: 1, WavPath: path + "/track01.cdda.wav"}}})
+	}
+	s.setRips(rips)
+
+	resp, err := s.BulkForce(context.Background(), &pbcdp.BulkForceRequest{Type: pbcdp.ForceRequest_RECONVERT_MP3, InstanceIds: []int32{1, 2, 3, 4, 5, 6}, Concurrency: 3})
+	if err != nil {
+		t.Fatalf("Bad force: %v", err)
+	}
+	for _, result := range resp.GetResults() {
+		if len(result.GetError()) > 0 {
+			t.Errorf("Force failed: %v", result)
+		}
+	}
+	if ripper.most != 3 || s.ripCount != 6 {
+		t.Errorf("Forces ran %v at once (%v conversions), expected 3", ripper.most, s.ripCount)
+	}
+}
+
+func TestBulkForceCancelled(t *testing.T) {
+	s := InitTestServer("testdata/")
+	s.save(context.Background(), &pbcdp.Config{})
+	s.setRips(append(s.getRips(), &pbcdp.Rip{Id: 1, Path: "1"}))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	resp, err := s.BulkForce(ctx, &pbcdp.BulkForceRequest{Type: pbcdp.ForceRequest_RESCAN_DISK, InstanceIds: []int32{1}})
+	if err != nil {
+		t.Fatalf("Bad force: %v", err)
+	}
+	if len(resp.GetResults()) != 1 || !strings.Contains(resp.GetResults()[0].GetError(), "canceled") || len(s.getRips()) != 2 {
+		t.Errorf("Cancelled force ran: %v, %v", resp, s.getRips())
+	}
+}
+
+func TestBulkForceFail(t *testing.T) {
+	s := InitTestServer("testdata/")
+	s.rc = &testRc{failGet: true}
+
+	resp, err := s.BulkForce(context.Background(), &pbcdp.BulkForceRequest{ReleaseId: 12345})
+	if err == nil {
+		t.Errorf("Bad lookup did not fail: %v", resp)
+	}
+
+	resp, err = s.BulkForce(context.Background(), &pbcdp.BulkForceRequest{})
+	if err == nil {
+		t.Errorf("Empty force did not fail: %v", resp)
+	}
+}
+
+func TestGetOutstanding(t *testing.T) {
+	s := InitTestServer("testdata/")
+	s.save(context.Background(), &pbcdp.Config{IssueMapping: map[int32]int32{12: 20}, SchemaVersion: latestSchema()})
+
+	config, err := s.load(context.Background())
+	if err != nil {
+		t.Fatalf("Bad load: %v", err)
+	}
+
+	err = s.verify(context.Background(), 1234, config)
+	if err == nil {
+		t.Fatalf("Verify did not fail")
+	}
+	s.save(context.Background(), config)
+
+	resp, err := s.GetOutstanding(context.Background(), &pbcdp.GetOutstandingRequest{})
+	if err != nil {
+		t.Fatalf("Bad outstanding: %v", err)
+	}
+
+	if len(resp.GetRecords()) != 2 || resp.GetRecords()[0].GetInstanceId() != 12 || resp.GetRecords()[1].GetInstanceId() != 1234 || len(resp.GetRecords()[1].GetDetail()) == 0 {
+		t.Errorf("Bad outstanding: %v", resp)
+	}
+}
+
+func TestQueueEditing(t *testing.T) {
+	s := InitTestServer("testdata/")
+	s.save(context.Background(), &pbcdp.Config{})
+
+	if _, err := s.Enqueue(context.Background(), &pbcdp.EnqueueRequest{InstanceId: 12, Reason: "Lost disc"}); err != nil {
+		t.Fatalf("Bad enqueue: %v", err)
+	}
+	if _, err := s.Enqueue(context.Background(), &pbcdp.EnqueueRequest{InstanceId: 13}); err != nil {
+		t.Fatalf("Bad enqueue: %v", err)
+	}
+
+	if _, err := s.Snooze(context.Background(), &pbcdp.SnoozeRequest{InstanceId: 12, Until: time.Now().Add(time.Hour).Unix(), Reason: "Away"}); err != nil {
+		t.Fatalf("Bad snooze: %v", err)
+	}
+
+	missing, err := s.GetMissing(context.Background(), &pbcdp.GetMissingRequest{})
+	if err != nil || len(missing.GetQueue()) != 1 || missing.GetQueue()[0].GetEntry().GetInstanceId() != 13 {
+		t.Errorf("Snoozed entry was returned: %v -> %v", missing, err)
+	}
+
+	missing, err = s.GetMissing(context.Background(), &pbcdp.GetMissingRequest{IncludeSnoozed: true})
+	if err != nil || len(missing.GetQueue()) != 2 || missing.GetQueue()[0].GetEntry().GetReason() != pbcdp.QueueEntry_MANUAL {
+		t.Errorf("Bad full queue: %v -> %v", missing, err)
+	}
+	if missing.GetQueue()[0].GetEntry().GetDetail() != "Lost disc" || missing.GetQueue()[0].GetEntry().GetSnoozeReason() != "Away" {
+		t.Errorf("Snooze replaced the detail: %v", missing.GetQueue()[0])
+	}
+
+	if _, err := s.Dequeue(context.Background(), &pbcdp.DequeueRequest{InstanceId: 12}); err != nil {
+		t.Fatalf("Bad dequeue: %v", err)
+	}
+
+	if _, err := s.Dequeue(context.Background(), &pbcdp.DequeueRequest{InstanceId: 12}); err == nil {
+		t.Errorf("Second dequeue did not fail")
+	}
+
+	if _, err := s.Snooze(context.Background(), &pbcdp.SnoozeRequest{InstanceId: 12}); err == nil {
+		t.Errorf("Snooze of missing entry did not fail")
+	}
+
+	config, _ := s.load(context.Background())
+	if len(config.GetToGo()) != 1 || len(config.GetToGoDetail()) != 1 {
+		t.Errorf("Bad queue: %v", config)
+	}
+}
+
+func TestGetTagPlan(t *testing.T) {
+	s := InitTestServer("testdata/")
+	tr := &testRipper{}
+	s.ripper = tr
+
+	plan, err := s.GetTagPlan(context.Background(), &pbcdp.GetTagPlanRequest{InstanceId: 12345})
+	if err != nil {
+		t.Fatalf("Bad plan: %v", err)
+	}
+
+	if len(plan.GetTracks()) == 0 || !plan.GetTracks()[0].GetSourceExists() || plan.GetTracks()[0].GetArtist() != "Hello" || len(plan.GetTracks()[0].GetOperations()) == 0 {
+		t.Errorf("Bad plan: %v", plan)
+	}
+
+	if len(tr.commands) > 0 {
+		t.Errorf("Plan ran commands: %v", tr.commands)
+	}
+}
+
+func TestGetTagPlanNoArtists(t *testing.T) {
+	s := InitTestServer("testdata/")
+	s.getter = &testGetter{override: &pbrc.Record{Release: &pbgd.Release{Id: 12345, InstanceId: 12345, FormatQuantity: 1,
+		Formats:   []*pbgd.Format{&pbgd.Format{Name: "CD", Qty: "1"}},
+		Tracklist: []*pbgd.Track{&pbgd.Track{TrackType: pbgd.Track_TRACK, Position: "1"}}}}}
+
+	plan, err := s.GetTagPlan(context.Background(), &pbcdp.GetTagPlanRequest{InstanceId: 12345})
+	if err != nil {
+		t.Fatalf("Bad plan: %v", err)
+	}
+	if len(plan.GetTracks()) != 1 || plan.GetTracks()[0].GetArtist() != "" {
+		t.Errorf("Bad plan: %v", plan)
+	}
+}
@@ -4,13 +4,16 @@ import (
 	"fmt"
 	"io/ioutil"
 	"os"
+	"path/filepath"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"golang.org/x/net/context"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 
 	pb "github.com/brotherlogic/cdprocessor/proto"
 	pbcdp "github.com/brotherlogic/cdprocessor/proto"
@@ -20,6 +23,13 @@ import (
 	"github.com/prometheus/client_golang/prometheus/promauto"
 )
 
+const (
+	// Error prefixes used to explain why a record is on the rip queue
+	missingTrack      = "Missing Track"
+	fileCountMismatch = "Error reading"
+	corruptOutput     = "Corrupt output"
+)
+
 var (
 	needsRip = promauto.NewGauge(prometheus.GaugeOpts{
 		Name: "cdprocessor_rips",
@@ -65,10 +75,8 @@ func (s *Server) verify(ctx context.Context, ID int32, config *pb.Config) error
 	return s.verifyRecord(ctx, record, config)
 }
 
-func (s *Server) verifyRecord(ctx context.Context, record *pbrc.Record, config *pb.Config) error {
-
-	t := time.Now()
-	files, err := ioutil.ReadDir(record.GetMetadata().CdPath)
+// expectedFiles counts the files we expect to find in the rip of a record
+func expectedFiles(record *pbrc.Record) int {
 	count := 0
 	trackSet := TrackExtract(record.GetRelease(), record.GetMetadata().GetGoalFolder() == 565206)
 	for _, track := range trackSet {
@@ -76,14 +84,55 @@ func (s *Server) verifyRecord(ctx context.Context, record *pbrc.Record, config *
 			count++
 		}
 	}
-	s.CtxLog(ctx, fmt.Sprintf("Read dir and built trackset in %v", time.Now().Sub(t)))
 
 	if count == 0 {
 		count = len(trackSet)
 	}
+	return count
+}
+
+// checkRecord reports what verifyRecord would find wrong with a record, without rescanning,
+// converting, relinking or touching its issue
+func (s *Server) checkRecord(ctx context.Context, record *pbrc.Record) error {
+	files, err := ioutil.ReadDir(record.GetMetadata().CdPath)
+	count := expectedFiles(record)
+	corrupt := s.storedCorrupt(record.GetRelease().GetId())
+
+	var problem error
+	switch {
+	case len(files) != count || err != nil:
+		problem = status.Errorf(codes.DataLoss, "%v %v/%v files for %v: (%v)", fileCountMismatch, len(files), count, record.GetRelease().GetId(), err)
+	case len(corrupt) > 0:
+		problem = status.Errorf(codes.DataLoss, "%v for %v: %v", corruptOutput, record.GetRelease().GetId(), strings.Join(corrupt, "; "))
+	}
+
+	if problem != nil {
+		s.addHistory(record.GetRelease().GetId(), record.GetRelease().GetInstanceId(), pb.HistoryEntry_VERIFY_FAILED, status.Convert(problem).Message(), nil)
+	} else {
+		s.addHistory(record.GetRelease().GetId(), record.GetRelease().GetInstanceId(), pb.HistoryEntry_VERIFY_PASSED, fmt.Sprintf("found %v files in %v", len(files), record.GetMetadata().GetCdPath()), nil)
+	}
+	return problem
+}
+
+func (s *Server) verifyRecord(ctx context.Context, record *pbrc.Record, config *pb.Config) error {
+
+	t := time.Now()
+	files, err := ioutil.ReadDir(record.GetMetadata().CdPath)
+	count := expectedFiles(record)
+	s.CtxLog(ctx, fmt.Sprintf("Read dir and built trackset in %v", time.Now().Sub(t)))
+
+	// Outputs are decoded in the background; we only look at what that's found
+	corrupt := s.storedCorrupt(record.GetRelease().GetId())
 
 	s.CtxLog(ctx, fmt.Sprintf("Processing (%v): %v / %v", record.GetRelease().GetInstanceId(), len(files), count))
-	err = s.adjustAlert(ctx, config, record, len(files) != count || err != nil)
+	if len(files) != count || err != nil {
+		s.addHistory(record.GetRelease().GetId(), record.GetRelease().GetInstanceId(), pb.HistoryEntry_VERIFY_FAILED, fmt.Sprintf("found %v files in %v, expected %v", len(files), record.GetMetadata().GetCdPath(), count), err)
+	} else if len(corrupt) > 0 {
+		s.addHistory(record.GetRelease().GetId(), record.GetRelease().GetInstanceId(), pb.HistoryEntry_VERIFY_FAILED, strings.Join(corrupt, "; "), nil)
+	} else {
+		s.addHistory(record.GetRelease().GetId(), record.GetRelease().GetInstanceId(), pb.HistoryEntry_VERIFY_PASSED, fmt.Sprintf("found %v files in %v", len(files), record.GetMetadata().GetCdPath()), nil)
+	}
+	err = s.adjustAlert(ctx, config, record, len(files) != count || err != nil || len(corrupt) > 0, fmt.Sprintf("Found %v files in %v, expected %v (%v) %v", len(files), record.GetMetadata().CdPath, count, err, corrupt))
 	if err != nil {
 		return err
 	}
@@ -120,10 +169,20 @@ func (s *Server) verifyRecord(ctx context.Context, record *pbrc.Record, config *
 				}
 				s.CtxLog(ctx, fmt.Sprintf("%v (Expected %v)", fstr, count))
 			}
-			return status.Error(codes.DataLoss, fmt.Sprintf("Error reading %v/%v files for %v: (%v)", len(files), count, record.GetRelease().GetId(), err))
+			return status.Error(codes.DataLoss, fmt.Sprintf("%v %v/%v files for %v: (%v)", fileCountMismatch, len(files), count, record.GetRelease().GetId(), err))
 		}
 	}
 
+	if len(corrupt) > 0 {
+		return status.Errorf(codes.DataLoss, "%v for %v: %v", corruptOutput, record.GetRelease().GetId(), strings.Join(corrupt, "; "))
+	}
+
+	// Remember what a good rip looks like, so the scrub can tell if it rots
+	err = s.queueChecksums(ctx, record.GetRelease().GetId())
+	if err != nil {
+		s.CtxLog(ctx, fmt.Sprintf("Unable to queue checksums for %v: %v", record.GetRelease().GetId(), err))
+	}
+
 	return nil
 }
 
@@ -135,12 +194,12 @@ func expand(v string) string {
 }
 
 func computeArtist(rec *pbgd.Release) string {
-	str := ""
+	var names []string
 	for _, artist := range rec.GetArtists() {
-		str += fmt.Sprintf("%v, ", artist.Name)
+		names = append(names, artist.GetName())
 	}
 
-	return str[:len(str)-2]
+	return strings.Join(names, ", ")
 }
 
 func (s *Server) makeLinks(ctx context.Context, ID int32, force bool, config *pb.Config) error {
@@ -152,106 +211,79 @@ func (s *Server) makeLinks(ctx context.Context, ID int32, force bool, config *pb
 		return err
 	}
 
-	if record.GetMetadata().GetFiledUnder() == pbrc.ReleaseMetadata_FILE_TAPE {
+	fired := firstFired(evaluateRules(eligibilityRules, record, force))
+	if fired != nil && fired.GetName() == "tape" {
 		return nil
 	}
 
-	config.GoalFolder[record.GetRelease().GetId()] = record.GetMetadata().GetGoalFolder()
+	config.GoalFolder[record.GetRelease().GetInstanceId()] = record.GetMetadata().GetGoalFolder()
 
-	// Skip records which aren't here yet
-	if record.GetMetadata().GetDateArrived() == 0 && time.Since(time.Unix(record.GetMetadata().GetDateAdded(), 0)) < time.Hour*24*365 {
-		s.CtxLog(ctx, "Skipping because it's not arrived yet")
+	if fired != nil {
+		s.CtxLog(ctx, fmt.Sprintf("Skipping because %v (%v)", fired.GetName(), fired.GetDetail()))
 		return nil
 	}
 
-	// Skip records which aren't in the listening pile
-	if record.GetRelease().GetFolderId() != 7664293 && record.GetRelease().GetFolderId() != 7651472 && record.GetRelease().GetFolderId() != 812802 {
-		s.CtxLog(ctx, "Skipping because it's not in the listening pile")
-		return nil
+	if staleForce(record, config) {
+		s.CtxLog(ctx, fmt.Sprintf("Setting force since %v", time.Since(time.Unix(config.GetLastProcessTime()[record.GetRelease().GetInstanceId()], 0))))
+		force = true
 	}
-
-	// Skip SOLD_ARCHIVE records
-	if !force && record.GetMetadata().GetCategory() == pbrc.ReleaseMetadata_SOLD_ARCHIVE {
-		s.CtxLog(ctx, "Skipping because it's SOLD_ARCHIVE")
-		return nil
-	}
-
-	// Skip records which aren't release yet
-	val, err := time.Parse("2006-01-02", record.GetRelease().GetReleased())
-	s.CtxLog(ctx, fmt.Sprintf("Got %v, %v", val, err))
-	if err == nil && val.After(time.Now()) {
-		s.CtxLog(ctx, "Skipping because it's UNRELEASED")
-		return nil
-	}
-
-	// Skip boxed records
-	if record.GetMetadata().GetBoxState() != pbrc.ReleaseMetadata_BOX_UNKNOWN &&
-		record.GetMetadata().GetBoxState() != pbrc.ReleaseMetadata_OUT_OF_BOX {
-		return nil
-	}
-
-	//Don't do anythng if we're in limbo folder
-	if record.GetRelease().GetFolderId() == 3380098 && record.GetMetadata().GetMoveFolder() == 0 {
-		return nil
-	}
-
-	if time.Since(time.Unix(config.GetLastProcessTime()[record.GetRelease().GetInstanceId()], 0)) > time.Hour*24*7 {
-		if config.GetLastProcessTime()[record.GetRelease().GetInstanceId()] > 0 {
-			s.CtxLog(ctx, fmt.Sprintf("Setting force since %v", time.Since(time.Unix(config.GetLastProcessTime()[record.GetRelease().GetInstanceId()], 0))))
-			force = true
-		}
+	if force {
+		s.addHistory(record.GetRelease().GetId(), record.GetRelease().GetInstanceId(), pb.HistoryEntry_FORCED, "relinking", nil)
 	}
 	err = s.runLinks(ctx, ID, force, record, config)
 	s.CtxLog(ctx, fmt.Sprintf("Error on run links: %v", err))
 
 	if err != nil {
+		s.addHistory(record.GetRelease().GetId(), record.GetRelease().GetInstanceId(), pb.HistoryEntry_FAILED, "running links", err)
 		return err
 	}
 	config.LastProcessTime[record.GetRelease().GetInstanceId()] = time.Now().Unix()
+	return nil
+}
 
-	if record.GetRelease().GetFolderId() != 7664293 {
-		return s.save(ctx, config)
-	}
+// replayLinkChanges applies what a link run changed, between before and after, to config.
+// The run's side effects are done by then, so a save that loses a race replays the changes
+// onto a fresh load rather than linking again.
+func replayLinkChanges(config, before, after *pb.Config) {
+	replayMap(&config.GoalFolder, before.GetGoalFolder(), after.GetGoalFolder(), func(a, b int32) bool { return a == b })
+	replayMap(&config.LastProcessTime, before.GetLastProcessTime(), after.GetLastProcessTime(), func(a, b int64) bool { return a == b })
+	replayMap(&config.LastRipTime, before.GetLastRipTime(), after.GetLastRipTime(), func(a, b int64) bool { return a == b })
+	replayMap(&config.IssueMapping, before.GetIssueMapping(), after.GetIssueMapping(), func(a, b int32) bool { return a == b })
+	replayMap(&config.Outstanding, before.GetOutstanding(), after.GetOutstanding(), func(a, b *pb.Outstanding) bool { return proto.Equal(a, b) })
+}
 
-	if err != nil {
-		return err
+func replayMap[V any](into *map[int32]V, before, after map[int32]V, same func(a, b V) bool) {
+	if *into == nil {
+		*into = make(map[int32]V)
+	}
+	for id, val := range after {
+		if old, ok := before[id]; !ok || !same(old, val) {
+			(*into)[id] = val
+		}
+	}
+	for id := range before {
+		if _, ok := after[id]; !ok {
+			delete(*into, id)
+		}
 	}
-
-	s.CtxLog(ctx, fmt.Sprintf("Adjust force and saving %v", time.Since(time.Unix(config.GetLastProcessTime()[record.GetRelease().GetInstanceId()], 0))))
-
-	return s.save(ctx, config)
 }
 
-func (s *Server) runLinks(ctx context.Context, ID int32, force bool, record *pbrc.Record, config *pb.Config) error {
-	s.CtxLog(ctx, fmt.Sprintf("Running linkes %v -> %v", ID, force))
-	// Don't process digital CDs
-	if record.GetMetadata().GetGoalFolder() == 268147 ||
-		record.GetMetadata().GetGoalFolder() == 1433217 {
-		s.CtxLog(ctx, fmt.Sprintf("Not processing digital CD (%v)", ID))
+// saveLinkChanges saves what a link run changed, if anything
+func (s *Server) saveLinkChanges(ctx context.Context, before, after *pb.Config) error {
+	if proto.Equal(before, after) {
 		return nil
 	}
+	return s.updateConfig(ctx, func(config *pb.Config) error {
+		replayLinkChanges(config, before, after)
+		return nil
+	})
+}
 
-	match := false
-	if record.GetMetadata().GetGoalFolder() != 242018 &&
-		record.GetMetadata().GetGoalFolder() != 1782105 &&
-		record.GetMetadata().GetGoalFolder() != 288751 &&
-		record.GetMetadata().GetGoalFolder() != 2274270 &&
-		record.GetMetadata().GetGoalFolder() != 565206 {
-		// Not a cd or a bandcamp or cd boxset
-		for _, format := range record.GetRelease().GetFormats() {
-			if format.GetName() == "File" || format.GetName() == "CD" || format.GetName() == "CDr" || format.GetName() == "Memory Stick" {
-				s.CtxLog(ctx, fmt.Sprintf("Matched %v on the format: %v", ID, format))
-				match = true
-			}
-		}
-	} else {
-		s.CtxLog(ctx, fmt.Sprintf("Matched %v since it has the right goal folder: %v ( => %v)", ID, record.GetMetadata().GetGoalFolder(), force))
-		match = true
-	}
-
-	// This is not a CD we can process
-	if !match {
-		s.CtxLog(ctx, fmt.Sprintf("Don't think %v is a rippable format", record.GetRelease().GetInstanceId()))
+func (s *Server) runLinks(ctx context.Context, ID int32, force bool, record *pbrc.Record, config *pb.Config) error {
+	s.CtxLog(ctx, fmt.Sprintf("Running linkes %v -> %v", ID, force))
+	// Don't process digital CDs or formats we can't rip
+	if fired := firstFired(evaluateRules(formatRules, record, force)); fired != nil {
+		s.CtxLog(ctx, fmt.Sprintf("Not processing %v because %v (%v)", ID, fired.GetName(), fired.GetDetail()))
 		return nil
 	}
 
@@ -267,22 +299,15 @@ func (s *Server) runLinks(ctx context.Context, ID int32, force bool, record *pbr
 		t := time.Now()
 		trackSet := TrackExtract(record.GetRelease(), record.GetMetadata().GetGoalFolder() == 565206)
 		s.CtxLog(ctx, fmt.Sprintf("Extracted %v tracks in %v", len(trackSet), time.Now().Sub(t)))
-		noTracks := false
-		for _, track := range trackSet {
-			if track.Format == "CD" || track.Format == "CDr" || track.Format == "File" {
-				noTracks = true
-			}
-		}
-		for _, track := range trackSet {
-			if track.Format == "CD" || track.Format == "CDr" || track.Format == "File" || !noTracks {
-				err := s.buildLink(ctx, track, record, config)
-				if err != nil {
-					return err
-				}
-			} else {
-				s.CtxLog(ctx, fmt.Sprintf("Skipping %v because %v", track.Position, track.Format))
+		tracks := rippableTracks(trackSet)
+		s.CtxLog(ctx, fmt.Sprintf("Linking %v of %v tracks", len(tracks), len(trackSet)))
+		for _, track := range tracks {
+			err := s.buildLink(ctx, track, record, config)
+			if err != nil {
+				return err
 			}
 		}
+		s.addHistory(record.GetRelease().GetId(), record.GetRelease().GetInstanceId(), pb.HistoryEntry_LINKS_BUILT, fmt.Sprintf("linked %v tracks into %v%v", len(tracks), s.flacdir, record.GetRelease().GetId()), nil)
 
 		return s.getter.updateRecord(ctx, record.GetRelease().GetInstanceId(), fmt.Sprintf("%v%v", s.flacdir, record.GetRelease().Id), "")
 	}
@@ -298,70 +323,285 @@ func prepend(val string) string {
 	}
 }
 
-func (s *Server) buildLink(ctx context.Context, track *TrackSet, record *pbrc.Record, config *pb.Config) error {
-	s.CtxLog(ctx, fmt.Sprintf("Building links: %v", track))
-	// Verify that the track exists
+// The stages of the link pipeline for a single track
+const (
+	stageCover = "cover"
+	stageLink  = "link"
+	stageTag   = "tag"
+)
+
+type linkCommand struct {
+	stage   string
+	command []string
+	delete  bool
+}
+
+// trackFiles holds the paths the link pipeline reads and writes for a track
+type trackFiles struct {
+	cover   string
+	oldmp3  string
+	newmp3  string
+	oldflac string
+	newflac string
+}
+
+func (s *Server) trackFiles(track *TrackSet, record *pbrc.Record) *trackFiles {
 	adder := ""
 	if record.GetRelease().FormatQuantity > 1 && record.GetMetadata().GetFiledUnder() != pbrc.ReleaseMetadata_FILE_DIGITAL {
 		adder = fmt.Sprintf("_%v", track.Disk)
 	}
 
-	trackPath := fmt.Sprintf("%v%v%v/track%v.cdda.flac", s.dir, record.GetRelease().Id, adder, expand(track.Position))
+	// Rips we've indexed may not be in the legacy layout, or use the legacy file names
+	ripDir := fmt.Sprintf("%v%v", record.GetRelease().Id, adder)
+	mp3 := fmt.Sprintf("%v/track%v.cdda.mp3", ripDir, expand(track.Position))
+	flac := fmt.Sprintf("%v/track%v.cdda.flac", ripDir, expand(track.Position))
+	if rip, t := s.indexedTrack(record.GetRelease().GetId(), track, len(adder) > 0); t != nil {
+		ripDir = rip.GetPath()
+		mp3 = outputPath(t, "mp3")
+		flac = outputPath(t, "flac")
+	}
 
+	return &trackFiles{
+		cover:   fmt.Sprintf("%v%v/cover.jpg", s.dir, ripDir),
+		oldmp3:  s.dir + mp3,
+		newmp3:  fmt.Sprintf("%v%v/track%v-%v.cdda.mp3", s.mp3dir, record.GetRelease().Id, track.Disk, expand(track.Position)),
+		oldflac: s.dir + flac,
+		newflac: fmt.Sprintf("%v%v/%v-%v.cdda.flac", s.flacdir, record.GetRelease().Id, track.Disk, expand(track.Position)),
+	}
+}
+
+// indexedTrack finds a track in the rip index; unless the release is ripped a disk to a
+// directory everything is in the one rip, as disk 1
+func (s *Server) indexedTrack(id int32, track *TrackSet, byDisk bool) (*pbcdp.Rip, *pbcdp.Track) {
+	disk := int64(1)
+	if byDisk {
+		d, err := strconv.ParseInt(track.Disk, 10, 32)
+		if err != nil {
+			return nil, nil
+		}
+		disk = d
+	}
+	position, err := strconv.ParseInt(track.Position, 10, 32)
+	if err != nil {
+		return nil, nil
+	}
+
+	for _, rip := range s.getRips() {
+		if rip.GetId() != id || int64(rip.GetDisk()) != disk {
+			continue
+		}
+		for _, t := range rip.GetTracks() {
+			if int64(t.GetTrackNumber()) == position {
+				return rip, t
+			}
+		}
+	}
+	return nil, nil
+}
+
+// outputPath is where the mp3 or flac of an indexed track is, or will be once it's converted
+func outputPath(t *pbcdp.Track, format string) string {
+	path := t.GetMp3Path()
+	if format == "flac" {
+		path = t.GetFlacPath()
+	}
+	if len(path) > 0 {
+		return path
+	}
+
+	for _, p := range []string{t.GetWavPath(), t.GetFlacPath(), t.GetMp3Path()} {
+		if len(p) > 0 {
+			return strings.TrimSuffix(p, filepath.Ext(p)) + "." + format
+		}
+	}
+	return ""
+}
+
+func (s *Server) trackPath(track *TrackSet, record *pbrc.Record) string {
+	return s.trackFiles(track, record).oldflac
+}
+
+// linkCommands lists, in order, the commands that build the links and tags for a track
+func (s *Server) linkCommands(track *TrackSet, record *pbrc.Record) []*linkCommand {
+	files := s.trackFiles(track, record)
+
+	commands := []*linkCommand{}
+	if len(record.GetRelease().GetImages()) > 0 {
+		commands = append(commands, &linkCommand{stage: stageCover, command: []string{"wget", record.GetRelease().GetImages()[0].GetUri(), "-O", files.cover}})
+	}
+
+	title := GetTitle(track)
+	commands = append(commands,
+		&linkCommand{stage: stageLink, command: []string{"ln", "-s", files.oldmp3, files.newmp3}},
+		&linkCommand{stage: stageTag, command: []string{"mp3info", "-n", fmt.Sprintf("%v", track.Position), files.newmp3}},
+		&linkCommand{stage: stageTag, command: []string{"mp3info", "-t", fmt.Sprintf("%v", title), files.newmp3}},
+		&linkCommand{stage: stageTag, command: []string{"mp3info", "-l", fmt.Sprintf("%v", record.GetRelease().Title), files.newmp3}},
+		&linkCommand{stage: stageTag, command: []string{"mp3info", "-a", computeArtist(record.GetRelease()), files.newmp3}},
+		&linkCommand{stage: stageTag, command: []string{"eyeD3", fmt.Sprintf("--text-frame=TPOS:\"%v/%v\"", track.Disk, record.GetRelease().FormatQuantity), files.newmp3}},
+		&linkCommand{stage: stageTag, command: []string{"eyeD3", "--to-v2.4", files.oldmp3}},
+		&linkCommand{stage: stageTag, command: []string{"eyeD3", "--add-image", fmt.Sprintf("%v:FRONT_COVER", files.cover), files.oldmp3}},
+	)
+
+	commands = append(commands,
+		&linkCommand{stage: stageTag, delete: true, command: []string{"metaflac", "--remove-tag=artist", fmt.Sprintf("--set-tag=artist=%v", computeArtist(record.GetRelease())), files.oldflac}},
+		&linkCommand{stage: stageTag, delete: true, command: []string{"metaflac", fmt.Sprintf("--set-tag=tracknumber=%v", track.Position), files.oldflac}},
+		&linkCommand{stage: stageTag, delete: true, command: []string{"metaflac", fmt.Sprintf("--set-tag=discnumber=%v", prepend(track.Disk)), files.oldflac}},
+		&linkCommand{stage: stageTag, delete: true, command: []string{"metaflac", "--remove-tag=title", fmt.Sprintf("--set-tag=title=%v", title), files.oldflac}},
+		&linkCommand{stage: stageTag, delete: true, command: []string{"metaflac", "--remove-tag=album", fmt.Sprintf("--set-tag=album=%v", record.GetRelease().Title), files.oldflac}},
+	)
+	if len(record.GetRelease().GetImages()) > 0 {
+		commands = append(commands, &linkCommand{stage: stageTag, delete: true, command: []string{"metaflac", fmt.Sprintf("--import-picture-from=%v", files.cover), files.oldflac}})
+	}
+	commands = append(commands, &linkCommand{stage: stageLink, command: []string{"ln", files.oldflac, files.newflac}})
+
+	return commands
+}
+
+// rippableTracks filters the trackset down to the tracks we expect to find on disk
+func rippableTracks(trackSet []*TrackSet) []*TrackSet {
+	noTracks := false
+	for _, track := range trackSet {
+		if track.Format == "CD" || track.Format == "CDr" || track.Format == "File" {
+			noTracks = true
+		}
+	}
+
+	var tracks []*TrackSet
+	for _, track := range trackSet {
+		if track.Format == "CD" || track.Format == "CDr" || track.Format == "File" || !noTracks {
+			tracks = append(tracks, track)
+		}
+	}
+	return tracks
+}
+
+func (s *Server) buildLink(ctx context.Context, track *TrackSet, record *pbrc.Record, config *pb.Config) error {
+	return s.runLinkStages(ctx, track, record, config, stageCover, stageLink, stageTag)
+}
+
+// runLinkStages runs the commands from the given stages for a single track
+func (s *Server) runLinkStages(ctx context.Context, track *TrackSet, record *pbrc.Record, config *pb.Config, stages ...string) error {
+	s.CtxLog(ctx, fmt.Sprintf("Building links (%v): %v", stages, track))
+	// Verify that the track exists
+	trackPath := s.trackPath(track, record)
 	if !s.fileExists(trackPath) {
 		s.CtxLog(ctx, fmt.Sprintf("Track %v does not exist", trackPath))
 		//s.verifyRecord(ctx, record, config)
-		err := s.adjustAlert(ctx, config, record, true)
+		err := s.adjustAlert(ctx, config, record, true, fmt.Sprintf("Missing track %v", trackPath))
 		if err != nil {
 			return err
 		}
-		return status.Errorf(codes.DataLoss, "Missing Track: %v (from %+v -> %v+)", trackPath, track, track.tracks[0])
+		return status.Errorf(codes.DataLoss, "%v: %v (from %+v -> %v+)", missingTrack, trackPath, track, track.tracks[0])
 	}
 
-	if len(record.GetRelease().GetImages()) > 0 {
-		s.ripper.runCommand(ctx, []string{"wget", record.GetRelease().GetImages()[0].GetUri(), "-O", fmt.Sprintf("%v%v%v/cover.jpg", s.dir, record.GetRelease().Id, adder)}, false)
+	for _, command := range s.linkCommands(track, record) {
+		for _, stage := range stages {
+			if command.stage == stage {
+				s.ripper.runCommand(ctx, command.command, command.delete)
+			}
+		}
 	}
 
-	title := GetTitle(track)
-	oldmp3 := fmt.Sprintf("%v%v%v/track%v.cdda.mp3", s.dir, record.GetRelease().Id, adder, expand(track.Position))
-	s.ripper.runCommand(ctx, []string{"ln", "-s", fmt.Sprintf("%v%v%v/track%v.cdda.mp3", s.dir, record.GetRelease().Id, adder, expand(track.Position)), fmt.Sprintf("%v%v/track%v-%v.cdda.mp3", s.mp3dir, record.GetRelease().Id, track.Disk, expand(track.Position))}, false)
-	s.ripper.runCommand(ctx, []string{"mp3info", "-n", fmt.Sprintf("%v", track.Position), fmt.Sprintf("%v%v/track%v-%v.cdda.mp3", s.mp3dir, record.GetRelease().Id, track.Disk, expand(track.Position))}, false)
-	s.ripper.runCommand(ctx, []string{"mp3info", "-t", fmt.Sprintf("%v", title), fmt.Sprintf("%v%v/track%v-%v.cdda.mp3", s.mp3dir, record.GetRelease().Id, track.Disk, expand(track.Position))}, false)
-	s.ripper.runCommand(ctx, []string{"mp3info", "-l", fmt.Sprintf("%v", record.GetRelease().Title), fmt.Sprintf("%v%v/track%v-%v.cdda.mp3", s.mp3dir, record.GetRelease().Id, track.Disk, expand(track.Position))}, false)
-	s.ripper.runCommand(ctx, []string{"mp3info", "-a", computeArtist(record.GetRelease()), fmt.Sprintf("%v%v/track%v-%v.cdda.mp3", s.mp3dir, record.GetRelease().Id, track.Disk, expand(track.Position))}, false)
-
-	s.ripper.runCommand(ctx, []string{"eyeD3", fmt.Sprintf("--text-frame=TPOS:\"%v/%v\"", track.Disk, record.GetRelease().FormatQuantity), fmt.Sprintf("%v%v/track%v-%v.cdda.mp3", s.mp3dir, record.GetRelease().Id, track.Disk, expand(track.Position))}, false)
-	s.ripper.runCommand(ctx, []string{"eyeD3", "--to-v2.4", oldmp3}, false)
-	s.ripper.runCommand(ctx, []string{"eyeD3", "--add-image", fmt.Sprintf("%v%v%v/cover.jpg:FRONT_COVER", s.dir, record.GetRelease().Id, adder), oldmp3}, false)
-
-	oldfile := fmt.Sprintf("%v%v%v/track%v.cdda.flac", s.dir, record.GetRelease().Id, adder, expand(track.Position))
-	//newfile := fmt.Sprintf("%v%v/%v-%v.cdda.flac", s.flacdir, record.Id, track.Disk, expand(track.Position))
-	s.ripper.runCommand(ctx, []string{"metaflac", "--remove-tag=artist", fmt.Sprintf("--set-tag=artist=%v", computeArtist(record.GetRelease())), oldfile}, true)
-	s.ripper.runCommand(ctx, []string{"metaflac", fmt.Sprintf("--set-tag=tracknumber=%v", track.Position), oldfile}, true)
-	s.ripper.runCommand(ctx, []string{"metaflac", fmt.Sprintf("--set-tag=discnumber=%v", prepend(track.Disk)), oldfile}, true)
-	s.ripper.runCommand(ctx, []string{"metaflac", "--remove-tag=title", fmt.Sprintf("--set-tag=title=%v", title), oldfile}, true)
-	s.ripper.runCommand(ctx, []string{"metaflac", "--remove-tag=album", fmt.Sprintf("--set-tag=album=%v", record.GetRelease().Title), oldfile}, true)
-	if len(record.GetRelease().GetImages()) > 0 {
-		s.ripper.runCommand(ctx, []string{"metaflac", fmt.Sprintf("--import-picture-from=%v%v%v/cover.jpg", s.dir, record.GetRelease().Id, adder), oldfile}, true)
+	disk, _ := strconv.ParseInt(track.Disk, 10, 32)
+	position, _ := strconv.ParseInt(track.Position, 10, 32)
+	s.publish(&pbcdp.RipEvent{Type: pbcdp.RipEvent_TRACK_LINKED, Id: record.GetRelease().GetId(), InstanceId: record.GetRelease().GetInstanceId(), Disk: int32(disk), Track: int32(position), Path: trackPath})
+
+	return nil
+}
+
+// retag rewrites the tags on every track of the record without touching links or covers
+func (s *Server) retag(ctx context.Context, record *pbrc.Record, config *pb.Config) error {
+	trackSet := TrackExtract(record.GetRelease(), record.GetMetadata().GetGoalFolder() == 565206)
+	for _, track := range rippableTracks(trackSet) {
+		err := s.runLinkStages(ctx, track, record, config, stageTag)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// queueConversion asks the ripper to build the mp3 or flac of a track from its wav, noting
+// the output as in flight so it isn't checked while it's being written
+func (s *Server) queueConversion(ctx context.Context, id int32, t *pbcdp.Track, flac bool) {
+	wav := t.GetWavPath()
+	out := wav[0:len(wav)-3] + "mp3"
+	if flac {
+		out = wav[0:len(wav)-3] + "flac"
+	}
+
+	// Forces can queue conversions at once
+	s.conversionLock.Lock()
+	s.conversions[out] = time.Now()
+	if flac {
+		s.flacCount++
+	} else {
+		s.ripCount++
 	}
-	//s.ripper.runCommand(ctx, []string{"metaflac", fmt.Sprintf("--set-tag=album=\"%v\"", record.Title), fmt.Sprintf("%v%v/%v-%v.cdda.flac", s.flacdir, record.Id, track.Disk, expand(track.Position))})
-	s.ripper.runCommand(ctx, []string{"ln", fmt.Sprintf("%v%v%v/track%v.cdda.flac", s.dir, record.GetRelease().Id, adder, expand(track.Position)), fmt.Sprintf("%v%v/%v-%v.cdda.flac", s.flacdir, record.GetRelease().Id, track.Disk, expand(track.Position))}, false)
+	s.conversionLock.Unlock()
+
+	if flac {
+		s.ripper.ripToFlac(ctx, s.dir+wav, s.dir+out)
+		s.publish(&pbcdp.RipEvent{Type: pbcdp.RipEvent_FLAC_QUEUED, Id: id, Disk: t.GetDisk(), Track: t.GetTrackNumber(), Path: wav})
+	} else {
+		s.ripper.ripToMp3(ctx, s.dir+wav, s.dir+out)
+		s.publish(&pbcdp.RipEvent{Type: pbcdp.RipEvent_MP3_QUEUED, Id: id, Disk: t.GetDisk(), Track: t.GetTrackNumber(), Path: wav})
+	}
+}
 
+// reconvert queues a fresh conversion of every wav in the rips of the given id
+func (s *Server) reconvert(ctx context.Context, id int32, flac bool) error {
+	count := 0
+	var failed []string
+	for _, rip := range s.getRips() {
+		if rip.Id == id {
+			for _, t := range rip.Tracks {
+				if len(t.WavPath) > 0 {
+					out := t.GetMp3Path()
+					if flac {
+						out = t.GetFlacPath()
+					}
+
+					// Neither encoder will write over what's there
+					if len(out) > 0 {
+						err := s.io.remove(out)
+						if err != nil {
+							s.CtxLog(ctx, fmt.Sprintf("Unable to remove %v: %v", out, err))
+							failed = append(failed, out)
+							continue
+						}
+					}
+
+					count++
+					s.queueConversion(ctx, id, t, flac)
+				}
+			}
+		}
+	}
+
+	if count > 0 {
+		s.rescanUnlessWatching(ctx)
+	}
+	if len(failed) > 0 {
+		return status.Errorf(codes.Internal, "Unable to remove %v to reconvert", strings.Join(failed, ", "))
+	}
+	if count == 0 {
+		return status.Errorf(codes.FailedPrecondition, "Unable to locate any wavs for %v", id)
+	}
 	return nil
 }
 
 func (s *Server) convertToMP3(ctx context.Context, id int32) error {
 	found := false
-	for _, rip := range s.rips {
+	for _, rip := range s.getRips() {
 		for _, t := range rip.Tracks {
 			if rip.Id == id {
 				found = true
 
-				if len(t.WavPath) > 0 && len(t.Mp3Path) == 0 && strings.Contains(t.WavPath, "track") {
+				if len(t.WavPath) > 0 && len(t.Mp3Path) == 0 {
 					s.CtxLog(ctx, fmt.Sprintf("Missing MP3: %v", s.dir+t.WavPath))
-					s.ripCount++
-					s.ripper.ripToMp3(ctx, s.dir+t.WavPath, s.dir+t.WavPath[0:len(t.WavPath)-3]+"mp3")
-					s.buildConfig(ctx)
+					s.queueConversion(ctx, id, t, false)
+					s.rescanUnlessWatching(ctx)
 					return nil
 				}
 			}
@@ -377,16 +617,15 @@ func (s *Server) convertToMP3(ctx context.Context, id int32) error {
 func (s *Server) convertToFlac(ctx context.Context, id int32) error {
 	time.Sleep(time.Second * 2)
 	found := false
-	for _, rip := range s.rips {
+	for _, rip := range s.getRips() {
 		if rip.Id == id {
 			found = true
 
 			for _, t := range rip.Tracks {
-				if len(t.WavPath) > 0 && len(t.FlacPath) == 0 && strings.Contains(t.WavPath, "track") {
+				if len(t.WavPath) > 0 && len(t.FlacPath) == 0 {
 					s.CtxLog(ctx, fmt.Sprintf("Missing FLAC: %v", s.dir+t.WavPath))
-					s.flacCount++
-					s.ripper.ripToFlac(ctx, s.dir+t.WavPath, s.dir+t.WavPath[0:len(t.WavPath)-3]+"flac")
-					s.buildConfig(ctx)
+					s.queueConversion(ctx, id, t, true)
+					s.rescanUnlessWatching(ctx)
 					return nil
 				}
 			}
@@ -399,70 +638,305 @@ func (s *Server) convertToFlac(ctx context.Context, id int32) error {
 	return nil
 }
 
+// buildConfig rescans the rip directory, only reading rips whose directory has changed since the last scan
 func (s *Server) buildConfig(ctx context.Context) error {
+	s.scanLock.Lock()
+	defer s.scanLock.Unlock()
+
 	files, err := s.io.readDir()
 	if err != nil {
 		return err
 	}
 
-	rips := []*pbcdp.Rip{}
+	current := s.getRips()
+	existing := make(map[string]*pbcdp.Rip)
+	for _, rip := range current {
+		existing[rip.GetPath()] = rip
+	}
+
+	// Each top level directory is read by its own worker, and holds one or more rips
+	var tops []os.FileInfo
 	for _, f := range files {
 		if f.IsDir() && f.Name() != "lost+found" {
-			name := f.Name()
-			id, disk, err := s.io.convert(name)
-			if err != nil {
-				s.CtxLog(ctx, fmt.Sprintf("Unable to convert %v -> %v", name, err))
+			tops = append(tops, f)
+		}
+	}
+	found := make([][]*pbcdp.Rip, len(tops))
+	errs := make([]error, len(tops))
+
+	workers := s.scanWorkers
+	if workers <= 0 {
+		workers = 1
+	}
+	sem := make(chan bool, workers)
+	wg := &sync.WaitGroup{}
+	for i, top := range tops {
+		wg.Add(1)
+		sem <- true
+		go func(i int, top os.FileInfo) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			found[i], errs[i] = s.scanTop(ctx, top, existing)
+		}(i, top)
+	}
+	wg.Wait()
+
+	var rips []*pbcdp.Rip
+	var failed []string
+	for i, top := range tops {
+		if errs[i] != nil {
+			failed = append(failed, fmt.Sprintf("%v: %v", top.Name(), errs[i]))
+			rips = append(rips, staleRips(current, top.Name(), errs[i])...)
+			continue
+		}
+		rips = append(rips, found[i]...)
+	}
+
+	if s.storeRips(ctx, current, rips) {
+		err = s.saveRipIndex(ctx)
+		if err != nil {
+			s.CtxLog(ctx, fmt.Sprintf("Unable to save rip index: %v", err))
+		}
+	}
+
+	if len(failed) > 0 {
+		return status.Errorf(codes.Unavailable, "Unable to read %v of %v rips: %v", len(failed), len(tops), strings.Join(failed, "; "))
+	}
+	return nil
+}
+
+// underTop reports if the rip lives in the given top level directory
+func underTop(rip *pbcdp.Rip, top string) bool {
+	return rip.GetPath() == top || strings.HasPrefix(rip.GetPath(), top+"/")
+}
+
+// staleRips keeps serving what we had for a directory we couldn't read, and marks it to be read again
+func staleRips(current []*pbcdp.Rip, top string, err error) []*pbcdp.Rip {
+	var rips []*pbcdp.Rip
+	for _, rip := range current {
+		if underTop(rip, top) {
+			stale := proto.Clone(rip).(*pbcdp.Rip)
+			stale.DirMtime = 0
+			stale.ScanError = err.Error()
+			rips = append(rips, stale)
+		}
+	}
+	return rips
+}
+
+// storeRips swaps in the new index, announcing new rips, and reports whether anything changed
+func (s *Server) storeRips(ctx context.Context, current, rips []*pbcdp.Rip) bool {
+	before := make(map[string]*pbcdp.Rip)
+	for _, rip := range current {
+		before[rip.GetPath()] = rip
+	}
+
+	changed := len(rips) != len(current)
+	var found []*pbcdp.Rip
+	for _, rip := range rips {
+		old, ok := before[rip.GetPath()]
+		if !ok {
+			s.publish(&pbcdp.RipEvent{Type: pbcdp.RipEvent_RIP_FOUND, Id: rip.GetId(), Disk: rip.GetDisk(), Path: rip.GetPath()})
+			found = append(found, rip)
+		}
+		if old != rip {
+			changed = true
+		}
+	}
+
+	if changed {
+		s.setRips(rips)
+	}
+
+	// New rips are watched whichever scan found them
+	if add := s.watcher(); add != nil && len(found) > 0 {
+		s.addWatches(ctx, add, "", found)
+	}
+	return changed
+}
+
+// scanTop finds the rips in a top level directory, either the directory itself or its per disk subfolders
+func (s *Server) scanTop(ctx context.Context, top os.FileInfo, existing map[string]*pbcdp.Rip) ([]*pbcdp.Rip, error) {
+	name := top.Name()
+	mtime := top.ModTime().UnixNano()
+	if rip, ok := existing[name]; ok && rip.GetDirMtime() == mtime {
+		if refreshed, err := s.refreshRip(ctx, rip); err == nil {
+			return []*pbcdp.Rip{refreshed}, nil
+		}
+	}
+
+	files, err := s.io.readSubdir(name)
+	if err != nil {
+		return nil, err
+	}
+
+	var rips []*pbcdp.Rip
+	for _, f := range files {
+		if !f.IsDir() {
+			continue
+		}
+		path := name + "/" + f.Name()
+		if _, _, err := s.io.convert(path); err != nil {
+			continue
+		}
+
+		if rip, ok := existing[path]; ok && rip.GetDirMtime() == f.ModTime().UnixNano() {
+			if refreshed, err := s.refreshRip(ctx, rip); err == nil {
+				rips = append(rips, refreshed)
 				continue
 			}
+		}
+		subfiles, err := s.io.readSubdir(path)
+		if err != nil {
+			return nil, err
+		}
+		rip, err := s.buildRip(ctx, path, f.ModTime().UnixNano(), subfiles, existing[path])
+		if err != nil {
+			return nil, err
+		}
+		rips = append(rips, rip)
+	}
+	if len(rips) > 0 {
+		return rips, nil
+	}
 
-			trackFiles, _ := s.io.readSubdir(f.Name())
-			//s.CtxLog(ctx, fmt.Sprintf("Read subdir in %v", time.Now().Sub(t)))
-			tracks := []*pbcdp.Track{}
-			for _, tf := range trackFiles {
-				if !tf.IsDir() && strings.Contains(tf.Name(), "track") {
-					trackNumber, _ := strconv.ParseInt(tf.Name()[5:7], 10, 32)
-
-					var foundTrack *pbcdp.Track
-					for _, t := range tracks {
-						if t.TrackNumber == int32(trackNumber) {
-							foundTrack = t
-						}
-					}
-					if foundTrack == nil {
-						foundTrack = &pbcdp.Track{TrackNumber: int32(trackNumber), Disk: disk}
-						tracks = append(tracks, foundTrack)
-					}
+	rip, err := s.buildRip(ctx, name, mtime, files, existing[name])
+	if err != nil {
+		s.CtxLog(ctx, fmt.Sprintf("Unable to convert %v -> %v", name, err))
+		return nil, nil
+	}
+	return []*pbcdp.Rip{rip}, nil
+}
 
-					if strings.HasSuffix(tf.Name(), "wav") {
-						foundTrack.WavPath = f.Name() + "/" + tf.Name()
-					} else if strings.HasSuffix(tf.Name(), "mp3") {
-						foundTrack.Mp3Path = f.Name() + "/" + tf.Name()
-					} else if strings.HasSuffix(tf.Name(), "flac") {
-						foundTrack.FlacPath = f.Name() + "/" + tf.Name()
-					}
-				}
+// refreshRip restats the files of a rip whose directory listing hasn't changed, rereading
+// any written in place since we last looked. It fails if a file can't be found, so the
+// directory can be listed again.
+func (s *Server) refreshRip(ctx context.Context, rip *pbcdp.Rip) (*pbcdp.Rip, error) {
+	var files []os.FileInfo
+	changed := false
+	for _, track := range rip.GetTracks() {
+		for _, known := range []struct {
+			path string
+			info *pbcdp.AudioInfo
+		}{{track.GetWavPath(), track.GetWav()}, {track.GetMp3Path(), track.GetMp3()}, {track.GetFlacPath(), track.GetFlac()}} {
+			if len(known.path) == 0 {
+				continue
 			}
-
-			rips = append(rips, &pbcdp.Rip{Id: id, Path: f.Name(), Tracks: tracks})
+			info, err := s.io.stat(known.path)
+			if err != nil {
+				return nil, err
+			}
+			if known.info == nil || known.info.GetSize() != info.Size() || known.info.GetMtime() != info.ModTime().Unix() {
+				changed = true
+			}
+			files = append(files, info)
 		}
 	}
+	if !changed {
+		return rip, nil
+	}
 
-	s.rips = rips
-	return nil
+	refreshed, err := s.buildRip(ctx, rip.GetPath(), rip.GetDirMtime(), files, rip)
+	if err != nil {
+		return nil, err
+	}
+	refreshed.Unrecognised = rip.GetUnrecognised()
+	return refreshed, nil
+}
+
+// buildRip makes the rip held in the given directory from its listing, keeping what we
+// know about any files unchanged since the previous build
+func (s *Server) buildRip(ctx context.Context, path string, mtime int64, files []os.FileInfo, previous *pbcdp.Rip) (*pbcdp.Rip, error) {
+	id, disk, err := s.io.convert(path)
+	if err != nil {
+		return nil, err
+	}
+	_, _, slug, _ := matchLayout(s.layouts, path)
+
+	tracks, unrecognised := s.tracksFrom(ctx, path, disk, files, previous)
+	return &pbcdp.Rip{Id: id, Path: path, Disk: disk, Slug: slug, Tracks: tracks, Unrecognised: unrecognised, DirMtime: mtime}, nil
+}
+
+// tracksFrom places the files listed in a rip directory into tracks
+func (s *Server) tracksFrom(ctx context.Context, name string, disk int32, trackFiles []os.FileInfo, previous *pbcdp.Rip) ([]*pbcdp.Track, []string) {
+	known := make(map[string]*pbcdp.AudioInfo)
+	for _, t := range previous.GetTracks() {
+		known[t.GetWavPath()] = t.GetWav()
+		known[t.GetMp3Path()] = t.GetMp3()
+		known[t.GetFlacPath()] = t.GetFlac()
+	}
+
+	tracks := []*pbcdp.Track{}
+	var unrecognised []string
+	for _, tf := range trackFiles {
+		if tf.IsDir() || isSidecar(tf.Name()) {
+			continue
+		}
+
+		trackNumber, format, err := parseTrackFile(tf.Name())
+		if err != nil {
+			s.CtxLog(ctx, fmt.Sprintf("Unrecognised file in %v: %v", name, err))
+			unrecognised = append(unrecognised, name+"/"+tf.Name())
+			continue
+		}
+
+		var foundTrack *pbcdp.Track
+		for _, t := range tracks {
+			if t.TrackNumber == trackNumber {
+				foundTrack = t
+			}
+		}
+		if foundTrack == nil {
+			foundTrack = &pbcdp.Track{TrackNumber: trackNumber, Disk: disk}
+			tracks = append(tracks, foundTrack)
+		}
+
+		info := known[name+"/"+tf.Name()]
+		if info == nil || info.GetSize() != tf.Size() || info.GetMtime() != tf.ModTime().Unix() {
+			info = s.io.audioInfo(name+"/"+tf.Name(), format, tf)
+			if len(info.GetError()) > 0 {
+				s.CtxLog(ctx, fmt.Sprintf("Unable to read %v/%v: %v", name, tf.Name(), info.GetError()))
+			}
+		}
+
+		switch format {
+		case "wav":
+			foundTrack.WavPath = name + "/" + tf.Name()
+			foundTrack.Wav = info
+		case "mp3":
+			foundTrack.Mp3Path = name + "/" + tf.Name()
+			foundTrack.Mp3 = info
+		case "flac":
+			foundTrack.FlacPath = name + "/" + tf.Name()
+			foundTrack.Flac = info
+		}
+	}
+	return tracks, unrecognised
 }
 
-func (s *Server) adjustAlert(ctx context.Context, config *pbcdp.Config, r *pbrc.Record, needs bool) error {
-	number, alreadySeen := config.GetIssueMapping()[r.GetRelease().GetId()]
-	s.CtxLog(ctx, fmt.Sprintf("ALERT %v and %v for %v from %v (%v)", number, alreadySeen, r.GetRelease().GetId(), config.GetIssueMapping(), needs))
+func (s *Server) adjustAlert(ctx context.Context, config *pbcdp.Config, r *pbrc.Record, needs bool, detail string) error {
+	number, alreadySeen := config.GetIssueMapping()[r.GetRelease().GetInstanceId()]
+	s.CtxLog(ctx, fmt.Sprintf("ALERT %v and %v for %v from %v (%v)", number, alreadySeen, r.GetRelease().GetInstanceId(), config.GetIssueMapping(), needs))
 	if needs && !alreadySeen {
 		issue, err := s.ImmediateIssue(ctx, fmt.Sprintf("CD Rip Need for %v", r.GetRelease().GetTitle()), fmt.Sprintf("https://www.discogs.com/madeup/release/%v", r.GetRelease().GetId()), false, false)
 		if err != nil {
 			return err
 		}
 		s.CtxLog(ctx, fmt.Sprintf("Adding issue %v -> %v", r.GetRelease(), issue))
-		config.IssueMapping[r.GetRelease().GetId()] = issue.GetNumber()
-
-		return s.save(ctx, config)
+		config.IssueMapping[r.GetRelease().GetInstanceId()] = issue.GetNumber()
+		if config.Outstanding == nil {
+			config.Outstanding = make(map[int32]*pbcdp.Outstanding)
+		}
+		config.Outstanding[r.GetRelease().GetInstanceId()] = &pbcdp.Outstanding{
+			ReleaseId:  r.GetRelease().GetId(),
+			InstanceId: r.GetRelease().GetInstanceId(),
+			Title:      r.GetRelease().GetTitle(),
+			Issue:      issue.GetNumber(),
+			Opened:     time.Now().Unix(),
+			Detail:     detail,
+		}
+		s.publish(&pbcdp.RipEvent{Type: pbcdp.RipEvent_ISSUE_OPENED, Id: r.GetRelease().GetId(), InstanceId: r.GetRelease().GetInstanceId(), Issue: issue.GetNumber()})
+		return nil
 	}
 
 	if alreadySeen && !needs {
@@ -470,12 +944,14 @@ func (s *Server) adjustAlert(ctx context.Context, config *pbcdp.Config, r *pbrc.
 		if err != nil && status.Convert(err).Code() != codes.NotFound {
 			return err
 		}
-		delete(config.IssueMapping, r.GetRelease().GetId())
+		delete(config.IssueMapping, r.GetRelease().GetInstanceId())
+		delete(config.Outstanding, r.GetRelease().GetInstanceId())
+		s.publish(&pbcdp.RipEvent{Type: pbcdp.RipEvent_ISSUE_CLOSED, Id: r.GetRelease().GetId(), InstanceId: r.GetRelease().GetInstanceId(), Issue: number})
 
 		// Update rip time
-		config.GetLastRipTime()[r.GetRelease().GetId()] = time.Now().Unix()
+		config.GetLastRipTime()[r.GetRelease().GetInstanceId()] = time.Now().Unix()
 		s.updateMetrics(ctx, config)
-		return s.save(ctx, config)
+		return nil
 	}
 
 	return nil
@@ -505,7 +981,10 @@ func (s *Server) adjustExisting(ctx context.Context) error {
 }
 
 func (s *Server) logMissing(ctx context.Context) error {
-	m, _ := s.GetMissing(context.Background(), &pbcdp.GetMissingRequest{})
+	m, err := s.GetMissing(ctx, &pbcdp.GetMissingRequest{})
+	if err != nil {
+		return err
+	}
 
 	needsRip.Set(float64(len(m.Missing)))
 	if len(m.Missing) > 0 {
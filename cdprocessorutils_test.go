@@ -4,12 +4,14 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"sync"
 	"testing"
 
 	keystoreclient "github.com/brotherlogic/keystore/client"
 	"golang.org/x/net/context"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 
 	pb "github.com/brotherlogic/cdprocessor/proto"
 	pbgd "github.com/brotherlogic/godiscogs/proto"
@@ -38,12 +40,20 @@ type testGetter struct {
 	updates    int
 	adjusted   map[int32]bool
 	override   *pbrc.Record
+	missing    map[int32]bool
+	records    map[int32]*pbrc.Record
 }
 
 func (t *testGetter) getRecord(ctx context.Context, id int32) (*pbrc.Record, error) {
 	if t.fail {
 		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("Built to fail"))
 	}
+	if t.missing[id] {
+		return nil, status.Errorf(codes.NotFound, "Unable to find %v", id)
+	}
+	if record, ok := t.records[id]; ok {
+		return record, nil
+	}
 	if t.override != nil {
 		return t.override, nil
 	}
@@ -73,19 +83,41 @@ func (t *testGetter) updateRecord(ctx context.Context, id int32, cdpath, filepat
 	return nil
 }
 
-type testRipper struct{}
+type testRipper struct {
+	commands [][]string
+}
 
 func (tr *testRipper) ripToMp3(ctx context.Context, pathIn, pathOut string) {
 	log.Printf("Ripping %v -> %v", pathIn, pathOut)
 }
 
 func (tr *testRipper) runCommand(ctx context.Context, command []string, delete bool) error {
+	tr.commands = append(tr.commands, command)
 	return nil
 }
 
 func (tr *testRipper) ripToFlac(ctx context.Context, pathIn, pathOut string) {
 	log.Printf("Ripping %v -> %v", pathIn, pathOut)
 }
+
+// lockedStore serialises the test keystore, which isn't safe to use from more than one goroutine
+type lockedStore struct {
+	store configStore
+	lock  sync.Mutex
+}
+
+func (l *lockedStore) load(ctx context.Context, key string, typ proto.Message) (proto.Message, error) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	return l.store.load(ctx, key, typ)
+}
+
+func (l *lockedStore) save(ctx context.Context, key string, message proto.Message) error {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	return l.store.save(ctx, key, message)
+}
+
 func InitTestServer(dir string) *Server {
 	s := Init(dir, dir+"mp3", dir+"flac")
 	s.io = &testIo{dir: dir}
@@ -93,9 +125,10 @@ func InitTestServer(dir string) *Server {
 	s.getter = &testGetter{}
 	s.SkipLog = true
 	s.SkipIssue = true
-	s.buildConfig(context.Background())
 	s.ripper = &testRipper{}
 	s.GoServer.KSclient = *keystoreclient.GetTestClient(".test")
+	s.store = &lockedStore{store: s.store}
+	s.buildConfig(context.Background())
 	return s
 }
 
@@ -0,0 +1,142 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/brotherlogic/goserver/utils"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/brotherlogic/cdprocessor/proto"
+	pbrc "github.com/brotherlogic/recordcollection/proto"
+)
+
+// deadReason reports why a record no longer belongs in the config, or "" if it does
+func (s *Server) deadReason(ctx context.Context, id int32) (string, error) {
+	record, err := s.getter.getRecord(ctx, id)
+	if err != nil {
+		code := status.Convert(err).Code()
+		if code == codes.NotFound || code == codes.OutOfRange {
+			return fmt.Sprintf("not in collection (%v)", code), nil
+		}
+		return "", err
+	}
+
+	switch record.GetMetadata().GetCategory() {
+	case pbrc.ReleaseMetadata_SOLD, pbrc.ReleaseMetadata_SOLD_ARCHIVE, pbrc.ReleaseMetadata_SOLD_OFFLINE:
+		return fmt.Sprintf("sold (%v)", record.GetMetadata().GetCategory()), nil
+	}
+
+	return "", nil
+}
+
+// compactConfig removes config entries for records which have left the collection. The
+// records are looked up before the config is locked, which is then only held to remove them.
+func (s *Server) compactConfig(ctx context.Context, dryRun bool) (*pb.CompactionReport, error) {
+	config, err := s.load(ctx)
+	if err != nil {
+		return nil, err
+	}
+	report := s.findDead(ctx, config, dryRun)
+	s.CtxLog(ctx, fmt.Sprintf("Compaction checked %v, skipped %v and removed %v (dry run: %v)", report.GetChecked(), len(report.GetSkipped()), len(report.GetRemoved()), dryRun))
+	if dryRun {
+		return report, nil
+	}
+
+	err = s.updateConfig(ctx, func(config *pb.Config) error {
+		for _, removed := range report.GetRemoved() {
+			id := removed.GetInstanceId()
+			delete(config.LastProcessTime, id)
+			delete(config.LastRipTime, id)
+			delete(config.GoalFolder, id)
+			delete(config.IssueMapping, id)
+			delete(config.Outstanding, id)
+			delete(config.Suppressed, id)
+			removeFromQueue(config, id)
+		}
+		config.LastCompaction = report
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return report, nil
+}
+
+// findDead looks up every record in the config, reporting those which have left the
+// collection. Unless this is a dry run their issues are closed, and any we can't close
+// are skipped.
+func (s *Server) findDead(ctx context.Context, config *pb.Config, dryRun bool) *pb.CompactionReport {
+	fields := make(map[int32][]string)
+	for id := range config.GetLastProcessTime() {
+		fields[id] = append(fields[id], "last_process_time")
+	}
+	for id := range config.GetLastRipTime() {
+		fields[id] = append(fields[id], "last_rip_time")
+	}
+	for id := range config.GetGoalFolder() {
+		fields[id] = append(fields[id], "goal_folder")
+	}
+	for id := range config.GetIssueMapping() {
+		fields[id] = append(fields[id], "issue_mapping")
+	}
+	for id := range config.GetOutstanding() {
+		fields[id] = append(fields[id], "outstanding")
+	}
+	for _, id := range config.GetToGo() {
+		fields[id] = append(fields[id], "to_go")
+	}
+	for id := range config.GetSuppressed() {
+		fields[id] = append(fields[id], "suppressed")
+	}
+
+	var ids []int32
+	for id := range fields {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool {
+		return ids[i] < ids[j]
+	})
+
+	report := &pb.CompactionReport{RunTime: time.Now().Unix(), DryRun: dryRun}
+	for _, id := range ids {
+		report.Checked++
+		reason, err := s.deadReason(ctx, id)
+		if err != nil {
+			s.CtxLog(ctx, fmt.Sprintf("Unable to check %v for compaction: %v", id, err))
+			report.Skipped = append(report.Skipped, &pb.CompactedRecord{InstanceId: id, Reason: err.Error(), Fields: fields[id]})
+			continue
+		}
+		if len(reason) == 0 {
+			continue
+		}
+
+		if number, ok := config.GetIssueMapping()[id]; ok && !dryRun {
+			err := s.DeleteIssue(ctx, number)
+			if err != nil && status.Convert(err).Code() != codes.NotFound {
+				s.CtxLog(ctx, fmt.Sprintf("Unable to close issue %v for %v: %v", number, id, err))
+				report.Skipped = append(report.Skipped, &pb.CompactedRecord{InstanceId: id, Reason: err.Error(), Fields: fields[id]})
+				continue
+			}
+		}
+		report.Removed = append(report.Removed, &pb.CompactedRecord{InstanceId: id, Reason: reason, Fields: fields[id]})
+	}
+	return report
+}
+
+// runCompaction compacts the config once a day
+func (s *Server) runCompaction() {
+	for {
+		ctx, cancel := utils.ManualContext("cdprocessor-compact", time.Hour)
+		_, err := s.compactConfig(ctx, false)
+		if err != nil {
+			s.CtxLog(ctx, fmt.Sprintf("Unable to compact config: %v", err))
+		}
+		cancel()
+
+		time.Sleep(time.Hour * 24)
+	}
+}
@@ -0,0 +1,55 @@
+package main
+
+import (
+	"context"
+	"testing"
+
+	pb "github.com/brotherlogic/cdprocessor/proto"
+)
+
+func TestCompactConfig(t *testing.T) {
+	s := InitTestServer("testdata/")
+	s.getter = &testGetter{missing: map[int32]bool{12: true}}
+	s.save(context.Background(), &pb.Config{
+		SchemaVersion:   latestSchema(),
+		LastProcessTime: map[int32]int64{12: 1, 13: 1},
+		IssueMapping:    map[int32]int32{12: 4},
+		ToGo:            []int32{12, 13},
+	})
+
+	report, err := s.compactConfig(context.Background(), true)
+	if err != nil || len(report.GetRemoved()) != 1 || report.GetChecked() != 2 {
+		t.Fatalf("Bad dry run: %v -> %v", report, err)
+	}
+
+	config, _ := s.load(context.Background())
+	if len(config.GetLastProcessTime()) != 2 {
+		t.Errorf("Dry run removed entries: %v", config)
+	}
+
+	resp, err := s.CompactConfig(context.Background(), &pb.CompactConfigRequest{})
+	if err != nil || len(resp.GetReport().GetRemoved()[0].GetFields()) != 3 {
+		t.Fatalf("Bad compaction: %v -> %v", resp, err)
+	}
+
+	config, _ = s.load(context.Background())
+	if len(config.GetLastProcessTime()) != 1 || len(config.GetIssueMapping()) != 0 || len(config.GetToGo()) != 1 || config.GetLastCompaction() == nil {
+		t.Errorf("Bad compacted config: %v", config)
+	}
+}
+
+func TestCompactConfigFail(t *testing.T) {
+	s := InitTestServer("testdata/")
+	s.getter = &testGetter{fail: true}
+	s.save(context.Background(), &pb.Config{SchemaVersion: latestSchema(), LastProcessTime: map[int32]int64{12: 1}})
+
+	report, err := s.compactConfig(context.Background(), false)
+	if err != nil || len(report.GetSkipped()) != 1 || len(report.GetRemoved()) != 0 {
+		t.Errorf("Bad lookup was not skipped: %v -> %v", report, err)
+	}
+
+	config, _ := s.load(context.Background())
+	if len(config.GetLastProcessTime()) != 1 {
+		t.Errorf("Skipped record was removed: %v", config)
+	}
+}
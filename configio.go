@@ -0,0 +1,204 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	pb "github.com/brotherlogic/cdprocessor/proto"
+)
+
+// validateConfig checks that a config is consistent enough to be saved
+func validateConfig(config *pb.Config) error {
+	if config.GetSchemaVersion() > latestSchema() {
+		return status.Errorf(codes.InvalidArgument, "schema version %v is newer than %v", config.GetSchemaVersion(), latestSchema())
+	}
+
+	for id, t := range config.GetLastProcessTime() {
+		if id <= 0 || t < 0 {
+			return status.Errorf(codes.InvalidArgument, "bad last_process_time entry %v: %v", id, t)
+		}
+	}
+	for id, t := range config.GetLastRipTime() {
+		if id <= 0 || t < 0 {
+			return status.Errorf(codes.InvalidArgument, "bad last_rip_time entry %v: %v", id, t)
+		}
+	}
+	for id := range config.GetGoalFolder() {
+		if id <= 0 {
+			return status.Errorf(codes.InvalidArgument, "bad goal_folder entry %v", id)
+		}
+	}
+
+	// Each issue is raised for a single instance
+	owners := make(map[int32]int32)
+	for id, number := range config.GetIssueMapping() {
+		if id <= 0 || number <= 0 {
+			return status.Errorf(codes.InvalidArgument, "bad issue_mapping entry %v: %v", id, number)
+		}
+		if owner, ok := owners[number]; ok {
+			return status.Errorf(codes.InvalidArgument, "issue %v is mapped to both %v and %v", number, owner, id)
+		}
+		owners[number] = id
+	}
+
+	for id, entry := range config.GetOutstanding() {
+		if entry.GetInstanceId() != id {
+			return status.Errorf(codes.InvalidArgument, "outstanding entry %v has instance id %v", id, entry.GetInstanceId())
+		}
+		if number, ok := config.GetIssueMapping()[id]; !ok || number != entry.GetIssue() {
+			return status.Errorf(codes.InvalidArgument, "outstanding entry %v has issue %v, issue_mapping has %v", id, entry.GetIssue(), number)
+		}
+	}
+
+	queued := make(map[int32]bool)
+	for _, id := range config.GetToGo() {
+		if id <= 0 || queued[id] {
+			return status.Errorf(codes.InvalidArgument, "bad or repeated to_go entry %v", id)
+		}
+		queued[id] = true
+	}
+	for id, entry := range config.GetToGoDetail() {
+		if !queued[id] || entry.GetInstanceId() != id {
+			return status.Errorf(codes.InvalidArgument, "to_go_detail entry %v does not match the queue", id)
+		}
+	}
+	for id, entry := range config.GetSuppressed() {
+		if queued[id] || entry.GetInstanceId() != id {
+			return status.Errorf(codes.InvalidArgument, "suppressed entry %v is queued or has instance id %v", id, entry.GetInstanceId())
+		}
+	}
+
+	return nil
+}
+
+func formatValue(field protoreflect.FieldDescriptor, val protoreflect.Value) string {
+	if field.Kind() == protoreflect.MessageKind {
+		data, err := protojson.Marshal(val.Message().Interface())
+		if err != nil {
+			return fmt.Sprintf("%v", err)
+		}
+		return string(data)
+	}
+	if field.Kind() == protoreflect.EnumKind {
+		if value := field.Enum().Values().ByNumber(val.Enum()); value != nil {
+			return string(value.Name())
+		}
+	}
+	return fmt.Sprintf("%v", val.Interface())
+}
+
+// diffConfig lists the changes needed to turn from into to
+func diffConfig(from, to *pb.Config) []string {
+	var diff []string
+	fm, tm := from.ProtoReflect(), to.ProtoReflect()
+	fields := fm.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		field := fields.Get(i)
+
+		switch {
+		case field.IsMap():
+			keys := make(map[string]protoreflect.MapKey)
+			fm.Get(field).Map().Range(func(k protoreflect.MapKey, v protoreflect.Value) bool {
+				keys[k.String()] = k
+				return true
+			})
+			tm.Get(field).Map().Range(func(k protoreflect.MapKey, v protoreflect.Value) bool {
+				keys[k.String()] = k
+				return true
+			})
+
+			var names []string
+			for name := range keys {
+				names = append(names, name)
+			}
+			sort.Strings(names)
+
+			for _, name := range names {
+				fv, inFrom := fm.Get(field).Map().Get(keys[name]), fm.Get(field).Map().Has(keys[name])
+				tv, inTo := tm.Get(field).Map().Get(keys[name]), tm.Get(field).Map().Has(keys[name])
+				switch {
+				case !inFrom:
+					diff = append(diff, fmt.Sprintf("+ %v[%v]: %v", field.Name(), name, formatValue(field.MapValue(), tv)))
+				case !inTo:
+					diff = append(diff, fmt.Sprintf("- %v[%v]: %v", field.Name(), name, formatValue(field.MapValue(), fv)))
+				case !fv.Equal(tv):
+					diff = append(diff, fmt.Sprintf("~ %v[%v]: %v -> %v", field.Name(), name, formatValue(field.MapValue(), fv), formatValue(field.MapValue(), tv)))
+				}
+			}
+		case field.IsList():
+			if !fm.Get(field).Equal(tm.Get(field)) {
+				diff = append(diff, fmt.Sprintf("~ %v: %v -> %v", field.Name(), formatList(field, fm.Get(field).List()), formatList(field, tm.Get(field).List())))
+			}
+		default:
+			if !fm.Get(field).Equal(tm.Get(field)) {
+				diff = append(diff, fmt.Sprintf("~ %v: %v -> %v", field.Name(), formatValue(field, fm.Get(field)), formatValue(field, tm.Get(field))))
+			}
+		}
+	}
+	return diff
+}
+
+func formatList(field protoreflect.FieldDescriptor, list protoreflect.List) []string {
+	var vals []string
+	for i := 0; i < list.Len(); i++ {
+		vals = append(vals, formatValue(field, list.Get(i)))
+	}
+	return vals
+}
+
+// ExportConfig returns the live config
+func (s *Server) ExportConfig(ctx context.Context, req *pb.ExportConfigRequest) (*pb.ExportConfigResponse, error) {
+	config, err := s.load(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ExportConfigResponse{Config: config}, nil
+}
+
+// ImportConfig replaces the live config with the one given, once it has been validated
+func (s *Server) ImportConfig(ctx context.Context, req *pb.ImportConfigRequest) (*pb.ImportConfigResponse, error) {
+	if req.GetConfig() == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "no config to import")
+	}
+	imported := proto.Clone(req.GetConfig()).(*pb.Config)
+
+	_, err := s.migrate(ctx, imported)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "unable to migrate import: %v", err)
+	}
+	err = validateConfig(imported)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.ImportConfigResponse{}
+	if req.GetDryRun() {
+		config, err := s.load(ctx)
+		if err != nil {
+			return nil, err
+		}
+		imported.Revision = config.GetRevision()
+		resp.Diff = diffConfig(config, imported)
+	} else {
+		err = s.updateConfig(ctx, func(config *pb.Config) error {
+			imported.Revision = config.GetRevision()
+			resp.Diff = diffConfig(config, imported)
+			proto.Reset(config)
+			proto.Merge(config, imported)
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	s.CtxLog(ctx, fmt.Sprintf("Imported config with %v changes (dry run: %v)", len(resp.GetDiff()), req.GetDryRun()))
+	return resp, nil
+}
@@ -0,0 +1,77 @@
+package main
+
+import (
+	"context"
+	"testing"
+
+	pb "github.com/brotherlogic/cdprocessor/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestExportImport(t *testing.T) {
+	s := InitTestServer("testdata/")
+	s.save(context.Background(), &pb.Config{SchemaVersion: latestSchema(), IssueMapping: map[int32]int32{12: 4}, ToGo: []int32{12}})
+
+	exported, err := s.ExportConfig(context.Background(), &pb.ExportConfigRequest{})
+	if err != nil {
+		t.Fatalf("Bad export: %v", err)
+	}
+
+	config := exported.GetConfig()
+	config.IssueMapping[12] = 5
+	config.IssueMapping[13] = 6
+	config.ToGo = nil
+
+	resp, err := s.ImportConfig(context.Background(), &pb.ImportConfigRequest{Config: config, DryRun: true})
+	if err != nil {
+		t.Fatalf("Bad dry run: %v", err)
+	}
+	expected := []string{"~ issue_mapping[12]: 4 -> 5", "+ issue_mapping[13]: 6", "~ to_go: [12] -> []"}
+	if len(resp.GetDiff()) != len(expected) {
+		t.Fatalf("Bad diff: %v", resp.GetDiff())
+	}
+	for i, line := range expected {
+		if resp.GetDiff()[i] != line {
+			t.Errorf("Bad diff line %v: %v, expected %v", i, resp.GetDiff()[i], line)
+		}
+	}
+
+	live, _ := s.load(context.Background())
+	if live.GetIssueMapping()[12] != 4 {
+		t.Errorf("Dry run changed the config: %v", live)
+	}
+
+	// Importing a stale export should still replace the config
+	s.save(context.Background(), live)
+	_, err = s.ImportConfig(context.Background(), &pb.ImportConfigRequest{Config: config})
+	if err != nil {
+		t.Fatalf("Bad import: %v", err)
+	}
+
+	live, _ = s.load(context.Background())
+	if live.GetIssueMapping()[12] != 5 || len(live.GetToGo()) != 0 {
+		t.Errorf("Import was not saved: %v", live)
+	}
+}
+
+func TestImportValidation(t *testing.T) {
+	s := InitTestServer("testdata/")
+	s.save(context.Background(), &pb.Config{SchemaVersion: latestSchema()})
+
+	bad := []*pb.Config{
+		nil,
+		{SchemaVersion: latestSchema() + 1},
+		{SchemaVersion: latestSchema(), IssueMapping: map[int32]int32{12: 0}},
+		{SchemaVersion: latestSchema(), IssueMapping: map[int32]int32{12: 4, 13: 4}},
+		{SchemaVersion: latestSchema(), Outstanding: map[int32]*pb.Outstanding{12: {InstanceId: 12, Issue: 4}}},
+		{SchemaVersion: latestSchema(), ToGo: []int32{12, 12}},
+		{SchemaVersion: latestSchema(), ToGoDetail: map[int32]*pb.QueueEntry{12: {InstanceId: 12}}},
+	}
+	for _, config := range bad {
+		_, err := s.ImportConfig(context.Background(), &pb.ImportConfigRequest{Config: config})
+		if status.Convert(err).Code() != codes.InvalidArgument {
+			t.Errorf("Bad config %v was imported: %v", config, err)
+		}
+	}
+}
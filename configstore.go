@@ -0,0 +1,111 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/brotherlogic/goserver"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+type configStore interface {
+	load(ctx context.Context, key string, typ proto.Message) (proto.Message, error)
+	save(ctx context.Context, key string, message proto.Message) error
+}
+
+// keystoreStore keeps the config in the keystore
+type keystoreStore struct {
+	server *goserver.GoServer
+}
+
+func (k *keystoreStore) load(ctx context.Context, key string, typ proto.Message) (proto.Message, error) {
+	data, _, err := k.server.KSclient.Read(ctx, key, typ)
+	return data, err
+}
+
+func (k *keystoreStore) save(ctx context.Context, key string, message proto.Message) error {
+	return k.server.KSclient.Save(ctx, key, message)
+}
+
+// fileStore keeps the config as json files in a local directory
+type fileStore struct {
+	dir string
+}
+
+func (f *fileStore) path(key string) string {
+	return filepath.Join(f.dir, strings.Trim(strings.ReplaceAll(key, "/", "_"), "_")+".json")
+}
+
+func (f *fileStore) load(ctx context.Context, key string, typ proto.Message) (proto.Message, error) {
+	data, err := os.ReadFile(f.path(key))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, status.Errorf(codes.NotFound, "Unable to locate %v", key)
+		}
+		return nil, err
+	}
+
+	err = protojson.Unmarshal(data, typ)
+	if err != nil {
+		return nil, err
+	}
+	return typ, nil
+}
+
+// save writes to a temporary file and renames it into place, so a crash never leaves a partial config
+func (f *fileStore) save(ctx context.Context, key string, message proto.Message) error {
+	data, err := protojson.MarshalOptions{Multiline: true}.Marshal(message)
+	if err != nil {
+		return err
+	}
+
+	err = os.MkdirAll(f.dir, 0755)
+	if err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(f.dir, ".config-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err = tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err = tmp.Sync(); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err = tmp.Close(); err != nil {
+		return err
+	}
+
+	if err = os.Rename(tmp.Name(), f.path(key)); err != nil {
+		return err
+	}
+
+	dir, err := os.Open(f.dir)
+	if err != nil {
+		return err
+	}
+	defer dir.Close()
+	return dir.Sync()
+}
+
+func buildStore(s *Server, kind, dir string) (configStore, error) {
+	switch kind {
+	case "keystore":
+		return &keystoreStore{server: s.GoServer}, nil
+	case "file":
+		return &fileStore{dir: dir}, nil
+	}
+	return nil, fmt.Errorf("Unknown config store: %v", kind)
+}
@@ -0,0 +1,150 @@
+package main
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/brotherlogic/cdprocessor/proto"
+)
+
+func TestFileStore(t *testing.T) {
+	dir, err := os.MkdirTemp("", "cdprocessor")
+	if err != nil {
+		t.Fatalf("Unable to make dir: %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	s := InitTestServer("testdata/")
+	store, err := buildStore(s, "file", dir)
+	if err != nil {
+		t.Fatalf("Bad store: %v", err)
+	}
+	s.store = store
+
+	if _, err := s.load(context.Background()); err == nil {
+		t.Errorf("Empty store returned a config")
+	}
+
+	err = s.save(context.Background(), &pb.Config{SchemaVersion: latestSchema(), ToGo: []int32{12}})
+	if err != nil {
+		t.Fatalf("Bad save: %v", err)
+	}
+
+	config, err := s.load(context.Background())
+	if err != nil || len(config.GetToGo()) != 1 || config.GetToGo()[0] != 12 {
+		t.Errorf("Bad load: %v -> %v", config, err)
+	}
+
+	files, _ := os.ReadDir(dir)
+	if len(files) != 1 {
+		t.Errorf("Temporary files left behind: %v", files)
+	}
+}
+
+func TestBadStore(t *testing.T) {
+	s := InitTestServer("testdata/")
+	store, err := buildStore(s, "madeup", "")
+	if err == nil {
+		t.Errorf("Bad store was built: %v", store)
+	}
+}
+
+func TestSaveConflict(t *testing.T) {
+	s := InitTestServer("testdata/")
+	s.save(context.Background(), &pb.Config{SchemaVersion: latestSchema()})
+
+	first, err := s.load(context.Background())
+	if err != nil {
+		t.Fatalf("Bad load: %v", err)
+	}
+	second, err := s.load(context.Background())
+	if err != nil {
+		t.Fatalf("Bad load: %v", err)
+	}
+
+	first.ToGo = append(first.ToGo, 12)
+	if err := s.save(context.Background(), first); err != nil {
+		t.Fatalf("First save failed: %v", err)
+	}
+
+	second.ToGo = append(second.ToGo, 13)
+	err = s.save(context.Background(), second)
+	if status.Convert(err).Code() != codes.Aborted {
+		t.Errorf("Stale save did not conflict: %v", err)
+	}
+}
+
+func TestUpdateConfigRetries(t *testing.T) {
+	s := InitTestServer("testdata/")
+	s.save(context.Background(), &pb.Config{SchemaVersion: latestSchema()})
+
+	attempts := 0
+	err := s.updateConfig(context.Background(), func(config *pb.Config) error {
+		attempts++
+		if attempts == 1 {
+			// Another node gets its save in first
+			other, err := s.load(context.Background())
+			if err != nil {
+				return err
+			}
+			other.ToGo = append(other.ToGo, 12)
+			if err := s.save(context.Background(), other); err != nil {
+				return err
+			}
+		}
+		config.ToGo = append(config.ToGo, 13)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Update failed: %v", err)
+	}
+
+	config, err := s.load(context.Background())
+	if err != nil {
+		t.Fatalf("Bad load: %v", err)
+	}
+	if attempts != 2 || len(config.GetToGo()) != 2 {
+		t.Errorf("Update was lost (%v attempts): %v", attempts, config.GetToGo())
+	}
+}
+
+func TestUpdateConfigFails(t *testing.T) {
+	s := InitTestServer("testdata/")
+	s.save(context.Background(), &pb.Config{SchemaVersion: latestSchema()})
+
+	attempts := 0
+	err := s.updateConfig(context.Background(), func(config *pb.Config) error {
+		attempts++
+		config.ToGo = append(config.ToGo, 12)
+		return status.Errorf(codes.FailedPrecondition, "failed")
+	})
+	if status.Convert(err).Code() != codes.FailedPrecondition || attempts != 1 {
+		t.Errorf("Bad update (%v attempts): %v", attempts, err)
+	}
+
+	config, _ := s.load(context.Background())
+	if len(config.GetToGo()) != 0 {
+		t.Errorf("Failed change was saved: %v", config)
+	}
+}
+
+func TestReplayLinkChanges(t *testing.T) {
+	before := &pb.Config{IssueMapping: map[int32]int32{12: 20, 13: 21}, GoalFolder: map[int32]int32{12: 1}}
+	after := &pb.Config{IssueMapping: map[int32]int32{12: 20, 14: 22}, GoalFolder: map[int32]int32{12: 2},
+		Outstanding: map[int32]*pb.Outstanding{14: {InstanceId: 14, Issue: 22}}}
+
+	// Someone else changed 15 while the links ran
+	config := &pb.Config{IssueMapping: map[int32]int32{12: 20, 13: 21, 15: 23}, GoalFolder: map[int32]int32{12: 1}, ToGo: []int32{15}}
+	replayLinkChanges(config, before, after)
+
+	if len(config.GetIssueMapping()) != 3 || config.GetIssueMapping()[14] != 22 || config.GetIssueMapping()[15] != 23 || config.GetIssueMapping()[13] != 0 {
+		t.Errorf("Bad issues: %v", config.GetIssueMapping())
+	}
+	if config.GetGoalFolder()[12] != 2 || config.GetOutstanding()[14].GetIssue() != 22 || len(config.GetToGo()) != 1 {
+		t.Errorf("Bad replay: %v", config)
+	}
+}
@@ -0,0 +1,172 @@
+package main
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/brotherlogic/goserver/utils"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	pb "github.com/brotherlogic/cdprocessor/proto"
+)
+
+const (
+	// HISTORY - where the record histories are stored
+	HISTORY = "/github.com/brotherlogic/cdprocessor/history"
+
+	// The number of entries we keep for each record
+	historyLimit = 100
+)
+
+// addHistory appends an entry to the history of the given release, dropping the oldest past the limit
+func (s *Server) addHistory(id, instanceID int32, entryType pb.HistoryEntry_Type, detail string, err error) {
+	entry := &pb.HistoryEntry{Type: entryType, Timestamp: time.Now().Unix(), InstanceId: instanceID, Detail: detail}
+	if err != nil {
+		entry.Error = err.Error()
+	}
+
+	s.historyLock.Lock()
+	defer s.historyLock.Unlock()
+
+	record, ok := s.history.GetRecords()[id]
+	if !ok {
+		record = &pb.RecordHistory{}
+		s.history.Records[id] = record
+	}
+
+	// Rips are rediscovered every time we start up, only the first sighting counts
+	if entryType == pb.HistoryEntry_RIP_FOUND {
+		for _, e := range record.GetEntries() {
+			if e.GetType() == pb.HistoryEntry_RIP_FOUND && e.GetDetail() == detail {
+				return
+			}
+		}
+	}
+
+	record.Entries = append(record.Entries, entry)
+	if len(record.Entries) > historyLimit {
+		record.Entries = record.Entries[len(record.Entries)-historyLimit:]
+	}
+	s.historyDirty = true
+}
+
+// historyFromEvent adds the events worth keeping to the history
+func (s *Server) historyFromEvent(event *pb.RipEvent) {
+	switch event.GetType() {
+	case pb.RipEvent_RIP_FOUND:
+		s.addHistory(event.GetId(), event.GetInstanceId(), pb.HistoryEntry_RIP_FOUND, fmt.Sprintf("rip directory %v", event.GetPath()), nil)
+	case pb.RipEvent_MP3_QUEUED:
+		s.addHistory(event.GetId(), event.GetInstanceId(), pb.HistoryEntry_MP3_QUEUED, fmt.Sprintf("disk %v track %v from %v", event.GetDisk(), event.GetTrack(), event.GetPath()), nil)
+	case pb.RipEvent_FLAC_QUEUED:
+		s.addHistory(event.GetId(), event.GetInstanceId(), pb.HistoryEntry_FLAC_QUEUED, fmt.Sprintf("disk %v track %v from %v", event.GetDisk(), event.GetTrack(), event.GetPath()), nil)
+	case pb.RipEvent_ISSUE_OPENED:
+		s.addHistory(event.GetId(), event.GetInstanceId(), pb.HistoryEntry_ISSUE_OPENED, fmt.Sprintf("issue %v", event.GetIssue()), nil)
+	case pb.RipEvent_ISSUE_CLOSED:
+		s.addHistory(event.GetId(), event.GetInstanceId(), pb.HistoryEntry_ISSUE_CLOSED, fmt.Sprintf("issue %v", event.GetIssue()), nil)
+	case pb.RipEvent_CORRUPT_FOUND:
+		s.addHistory(event.GetId(), event.GetInstanceId(), pb.HistoryEntry_CORRUPT_FOUND, fmt.Sprintf("disk %v track %v in %v", event.GetDisk(), event.GetTrack(), event.GetPath()), nil)
+	}
+}
+
+func (s *Server) loadHistory(ctx context.Context) error {
+	data, err := s.store.load(ctx, HISTORY, &pb.History{})
+	if err != nil {
+		code := status.Convert(err).Code()
+		if code == codes.NotFound || code == codes.InvalidArgument {
+			return nil
+		}
+		return err
+	}
+
+	history := data.(*pb.History)
+	if history.Records == nil {
+		history.Records = make(map[int32]*pb.RecordHistory)
+	}
+
+	s.historyLock.Lock()
+	defer s.historyLock.Unlock()
+
+	// Keep anything that happened before the load finished
+	for id, record := range s.history.GetRecords() {
+		existing, ok := history.Records[id]
+		if !ok {
+			history.Records[id] = record
+			continue
+		}
+		existing.Entries = append(existing.Entries, record.GetEntries()...)
+		if len(existing.Entries) > historyLimit {
+			existing.Entries = existing.Entries[len(existing.Entries)-historyLimit:]
+		}
+	}
+	s.history = history
+	return nil
+}
+
+// saveHistory writes the history out, if anything has been added since the last save
+func (s *Server) saveHistory(ctx context.Context) error {
+	s.historyLock.Lock()
+	if !s.historyDirty {
+		s.historyLock.Unlock()
+		return nil
+	}
+	history := proto.Clone(s.history)
+	s.historyDirty = false
+	s.historyLock.Unlock()
+
+	err := s.store.save(ctx, HISTORY, history)
+	if err != nil {
+		s.historyLock.Lock()
+		s.historyDirty = true
+		s.historyLock.Unlock()
+	}
+	return err
+}
+
+// runHistorySave saves the history every minute
+func (s *Server) runHistorySave() {
+	for {
+		time.Sleep(time.Minute)
+
+		ctx, cancel := utils.ManualContext("cdprocessor-history", time.Minute)
+		err := s.saveHistory(ctx)
+		if err != nil {
+			s.CtxLog(ctx, fmt.Sprintf("Unable to save history: %v", err))
+		}
+		cancel()
+	}
+}
+
+// GetHistory returns the processing history of a record
+func (s *Server) GetHistory(ctx context.Context, req *pb.GetHistoryRequest) (*pb.GetHistoryResponse, error) {
+	s.historyLock.Lock()
+	defer s.historyLock.Unlock()
+
+	record, ok := s.history.GetRecords()[req.GetId()]
+	if !ok {
+		for _, r := range s.history.GetRecords() {
+			for _, entry := range r.GetEntries() {
+				if entry.GetInstanceId() == req.GetId() {
+					record = r
+					ok = true
+				}
+			}
+		}
+	}
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "No history for %v", req.GetId())
+	}
+
+	entries := record.GetEntries()
+	if req.GetMax() > 0 && int(req.GetMax()) < len(entries) {
+		entries = entries[len(entries)-int(req.GetMax()):]
+	}
+
+	resp := &pb.GetHistoryResponse{}
+	for _, entry := range entries {
+		resp.Entries = append(resp.Entries, proto.Clone(entry).(*pb.HistoryEntry))
+	}
+	return resp, nil
+}
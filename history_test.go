@@ -0,0 +1,94 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	pb "github.com/brotherlogic/cdprocessor/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestHistory(t *testing.T) {
+	s := InitTestServer("testdata/")
+	s.save(context.Background(), &pb.Config{})
+
+	_, err := s.Force(context.Background(), &pb.ForceRequest{Type: pb.ForceRequest_RECONVERT_MP3, Id: 12345})
+	if err != nil {
+		t.Fatalf("Bad force: %v", err)
+	}
+
+	// A rescan should not add a second sighting of the rip
+	s.rips = []*pb.Rip{}
+	s.buildConfig(context.Background())
+
+	resp, err := s.GetHistory(context.Background(), &pb.GetHistoryRequest{Id: 12345})
+	if err != nil {
+		t.Fatalf("Bad history: %v", err)
+	}
+
+	types := []pb.HistoryEntry_Type{pb.HistoryEntry_RIP_FOUND, pb.HistoryEntry_FORCED, pb.HistoryEntry_MP3_QUEUED, pb.HistoryEntry_MP3_QUEUED, pb.HistoryEntry_MP3_QUEUED}
+	if len(resp.GetEntries()) != len(types) {
+		t.Fatalf("Wrong number of entries: %v", resp.GetEntries())
+	}
+	for i, entry := range resp.GetEntries() {
+		if entry.GetType() != types[i] {
+			t.Errorf("Entry %v is %v, expected %v", i, entry, types[i])
+		}
+	}
+
+	resp, err = s.GetHistory(context.Background(), &pb.GetHistoryRequest{Id: 12345, Max: 2})
+	if err != nil || len(resp.GetEntries()) != 2 {
+		t.Errorf("Bad limited history: %v -> %v", resp, err)
+	}
+}
+
+func TestHistoryFailure(t *testing.T) {
+	s := InitTestServer("testdata/")
+	s.addHistory(12, 13, pb.HistoryEntry_FAILED, "running links", fmt.Errorf("Built to fail"))
+
+	resp, err := s.GetHistory(context.Background(), &pb.GetHistoryRequest{Id: 13})
+	if err != nil || len(resp.GetEntries()) != 1 || resp.GetEntries()[0].GetError() != "Built to fail" {
+		t.Errorf("Bad history by instance: %v -> %v", resp, err)
+	}
+
+	_, err = s.GetHistory(context.Background(), &pb.GetHistoryRequest{Id: 14})
+	if status.Convert(err).Code() != codes.NotFound {
+		t.Errorf("Missing history did not fail: %v", err)
+	}
+}
+
+func TestHistoryLimit(t *testing.T) {
+	s := InitTestServer("testdata/")
+	for i := 0; i < historyLimit+10; i++ {
+		s.addHistory(12, 12, pb.HistoryEntry_LINKS_BUILT, fmt.Sprintf("%v", i), nil)
+	}
+
+	resp, err := s.GetHistory(context.Background(), &pb.GetHistoryRequest{Id: 12})
+	if err != nil || len(resp.GetEntries()) != historyLimit || resp.GetEntries()[0].GetDetail() != "10" {
+		t.Errorf("History was not trimmed: %v", err)
+	}
+}
+
+func TestHistorySaveAndLoad(t *testing.T) {
+	s := InitTestServer("testdata/")
+	s.addHistory(12, 12, pb.HistoryEntry_LINKS_BUILT, "saved", nil)
+
+	err := s.saveHistory(context.Background())
+	if err != nil {
+		t.Fatalf("Bad save: %v", err)
+	}
+
+	s.history = &pb.History{Records: make(map[int32]*pb.RecordHistory)}
+	s.addHistory(12, 12, pb.HistoryEntry_LINKS_BUILT, "unsaved", nil)
+	err = s.loadHistory(context.Background())
+	if err != nil {
+		t.Fatalf("Bad load: %v", err)
+	}
+
+	resp, err := s.GetHistory(context.Background(), &pb.GetHistoryRequest{Id: 12})
+	if err != nil || len(resp.GetEntries()) != 2 || resp.GetEntries()[0].GetDetail() != "saved" {
+		t.Errorf("Bad loaded history: %v -> %v", resp, err)
+	}
+}
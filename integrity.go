@@ -0,0 +1,430 @@
+package main
+
+import (
+	"bytes"
+	"crypto/md5"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	goio "io"
+	"os"
+	"sort"
+	"time"
+
+	"github.com/brotherlogic/goserver/utils"
+	"github.com/mewkiz/flac"
+	"golang.org/x/net/context"
+	"google.golang.org/protobuf/proto"
+
+	pb "github.com/brotherlogic/cdprocessor/proto"
+)
+
+const (
+	// Outputs written more recently than this may still be being written
+	outputSettle = time.Minute * 10
+
+	// How long a queued conversion has to write its output
+	conversionTimeout = time.Hour
+
+	// How many decodes in a row have to fail before an output is rebuilt
+	confirmDecodes = 2
+
+	// How often we look for outputs to decode
+	decodePoll = time.Minute * 10
+)
+
+// decodeError is a file which was read but didn't decode, as opposed to one we couldn't read
+type decodeError struct {
+	err error
+}
+
+func (e *decodeError) Error() string {
+	return e.err.Error()
+}
+
+func (e *decodeError) Unwrap() error {
+	return e.err
+}
+
+// isDecodeError tells whether err means the file is damaged, rather than unreadable
+func isDecodeError(err error) bool {
+	var derr *decodeError
+	return errors.As(err, &derr)
+}
+
+// errReader remembers the first error reading a file, so it isn't mistaken for bad data
+type errReader struct {
+	r   goio.Reader
+	err error
+}
+
+func (r *errReader) Read(p []byte) (int, error) {
+	n, err := r.r.Read(p)
+	if err != nil && err != goio.EOF && r.err == nil {
+		r.err = err
+	}
+	return n, err
+}
+
+// checkFlac decodes every frame of a flac, checking the audio against the STREAMINFO MD5
+func checkFlac(path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	r := &errReader{r: f}
+	err = decodeFlac(r)
+	if r.err != nil {
+		return r.err
+	}
+	if err != nil {
+		return &decodeError{err: err}
+	}
+	return nil
+}
+
+func decodeFlac(r goio.Reader) error {
+	stream, err := flac.New(r)
+	if err != nil {
+		return fmt.Errorf("bad flac header: %w", err)
+	}
+
+	sum := md5.New()
+	samples := uint64(0)
+	for {
+		frame, err := stream.ParseNext()
+		if errors.Is(err, goio.EOF) {
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("bad frame after %v samples: %w", samples, err)
+		}
+		frame.Hash(sum)
+		samples += uint64(frame.BlockSize)
+	}
+
+	if stream.Info.NSamples > 0 && samples != stream.Info.NSamples {
+		return fmt.Errorf("decoded %v samples, expected %v", samples, stream.Info.NSamples)
+	}
+	// Encoders which can't seek back leave the MD5 unset
+	if stream.Info.MD5sum != [md5.Size]uint8{} && !bytes.Equal(sum.Sum(nil), stream.Info.MD5sum[:]) {
+		return fmt.Errorf("audio MD5 %x does not match STREAMINFO %x", sum.Sum(nil), stream.Info.MD5sum)
+	}
+	return nil
+}
+
+// mp3End finds where the frames of an mp3 stop, ahead of any trailing ID3v1 or APE tags
+func mp3End(data []byte) int64 {
+	end := int64(len(data))
+	if end >= 128 && string(data[end-128:end-125]) == "TAG" {
+		end -= 128
+	}
+	if end >= 32 && string(data[end-32:end-24]) == "APETAGEX" {
+		footer := data[end-32 : end]
+		end -= int64(binary.LittleEndian.Uint32(footer[12:16]))
+		if binary.LittleEndian.Uint32(footer[20:24])&0x80000000 != 0 {
+			end -= 32
+		}
+	}
+	if end < 0 {
+		end = 0
+	}
+	return end
+}
+
+// checkMp3 walks every frame of an mp3, from the first to the end of the file
+func checkMp3(path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	err = decodeMp3(data)
+	if err != nil {
+		return &decodeError{err: err}
+	}
+	return nil
+}
+
+func decodeMp3(data []byte) error {
+	r := bytes.NewReader(data)
+	end := mp3End(data)
+	off, _, _, err := findMp3Frame(r, skipID3(r), end)
+	if err != nil {
+		return err
+	}
+
+	frames := 0
+	for off < end {
+		if end-off < 4 {
+			return fmt.Errorf("%v stray bytes after %v frames", end-off, frames)
+		}
+		frame, err := parseMp3Frame(data[off : off+4])
+		if err != nil {
+			return fmt.Errorf("lost sync at byte %v of %v after %v frames", off, end, frames)
+		}
+		if off+frame.length > end {
+			return fmt.Errorf("frame %v truncated at byte %v of %v", frames, off, end)
+		}
+		off += frame.length
+		frames++
+	}
+	return nil
+}
+
+// checkAudioFile fully decodes an mp3 or flac
+func checkAudioFile(path, format string) error {
+	switch format {
+	case "flac":
+		return checkFlac(path)
+	case "mp3":
+		return checkMp3(path)
+	}
+	return fmt.Errorf("unable to check %v files", format)
+}
+
+// needsDecode tells whether an output hasn't been decoded since it last changed, or failed to
+// decode and needs confirming
+func needsDecode(info *pb.AudioInfo) bool {
+	return info.GetChecked() == 0 || (len(info.GetCorrupt()) > 0 && info.GetFailures() < confirmDecodes)
+}
+
+// throttle holds a decode of size bytes which began at start to the scrub rate
+func (s *Server) throttle(start time.Time, size int64) {
+	if s.scrubRate > 0 {
+		if ahead := time.Duration(size*int64(time.Second)/s.scrubRate) - time.Since(start); ahead > 0 {
+			time.Sleep(ahead)
+		}
+	}
+}
+
+// checkedOutput is an mp3 or flac of a track along with the result of decoding it
+type checkedOutput struct {
+	track  *pb.Track
+	path   string
+	format string
+	info   *pb.AudioInfo
+}
+
+// settling tells whether an output may still be being written, either because it changed
+// recently or because we've queued a conversion to write it that hasn't had time to finish
+func (s *Server) settling(path string, info *pb.AudioInfo) bool {
+	mtime := time.Unix(info.GetMtime(), 0)
+	if time.Since(mtime) < outputSettle {
+		return true
+	}
+
+	s.conversionLock.Lock()
+	defer s.conversionLock.Unlock()
+	queued, ok := s.conversions[path]
+	if !ok {
+		return false
+	}
+	if mtime.After(queued) || time.Since(queued) > conversionTimeout {
+		delete(s.conversions, path)
+		return false
+	}
+	return true
+}
+
+// decodeOutputs fully decodes the settled mp3s and flacs of a release that haven't been checked
+// since they last changed, or which failed to decode and need it confirming. It returns the
+// fresh results, those of them newly confirmed corrupt, and every output of the release
+// confirmed corrupt. Nothing is written back.
+func (s *Server) decodeOutputs(ctx context.Context, id int32) (map[string]*pb.AudioInfo, []*checkedOutput, []string) {
+	checked := make(map[string]*pb.AudioInfo)
+	var fresh []*checkedOutput
+	var corrupt []string
+	for _, rip := range s.getRips() {
+		if rip.GetId() != id {
+			continue
+		}
+		for _, track := range rip.GetTracks() {
+			for _, out := range []*checkedOutput{
+				{track: track, path: track.GetMp3Path(), format: "mp3", info: track.GetMp3()},
+				{track: track, path: track.GetFlacPath(), format: "flac", info: track.GetFlac()},
+			} {
+				if len(out.path) == 0 || s.settling(out.path, out.info) {
+					continue
+				}
+
+				if needsDecode(out.info) {
+					info := &pb.AudioInfo{}
+					if out.info != nil {
+						info = proto.Clone(out.info).(*pb.AudioInfo)
+					}
+
+					// Files we can't read are left unchecked, to try again later
+					start := time.Now()
+					err := s.io.checkAudio(out.path, out.format)
+					s.throttle(start, out.info.GetSize())
+					if err != nil && !isDecodeError(err) {
+						s.CtxLog(ctx, fmt.Sprintf("Unable to read %v: %v", out.path, err))
+						continue
+					}
+
+					info.Checked = time.Now().Unix()
+					info.Corrupt = ""
+					info.Failures = 0
+					if err != nil {
+						info.Corrupt = err.Error()
+						info.Failures = out.info.GetFailures() + 1
+					}
+					out.info = info
+					checked[out.path] = info
+					if info.GetFailures() >= confirmDecodes {
+						fresh = append(fresh, out)
+					}
+				}
+
+				if out.info.GetFailures() >= confirmDecodes {
+					corrupt = append(corrupt, fmt.Sprintf("%v: %v", out.path, out.info.GetCorrupt()))
+				}
+			}
+		}
+	}
+	return checked, fresh, corrupt
+}
+
+// checkOutputs decodes the outputs of a release, removing any newly confirmed corrupt ones we
+// can rebuild and queueing them for reconversion. It returns every output of the release
+// confirmed corrupt.
+func (s *Server) checkOutputs(ctx context.Context, id int32) []string {
+	checked, fresh, corrupt := s.decodeOutputs(ctx, id)
+
+	if len(checked) > 0 {
+		s.recordChecks(checked)
+		err := s.saveRipIndex(ctx)
+		if err != nil {
+			s.CtxLog(ctx, fmt.Sprintf("Unable to save checks of %v: %v", id, err))
+		}
+	}
+
+	for _, out := range fresh {
+		s.CtxLog(ctx, fmt.Sprintf("Corrupt %v: %v", out.path, out.info.GetCorrupt()))
+		s.publish(&pb.RipEvent{Type: pb.RipEvent_CORRUPT_FOUND, Id: id, Disk: out.track.GetDisk(), Track: out.track.GetTrackNumber(), Path: out.path})
+
+		if len(out.track.GetWavPath()) == 0 {
+			s.CtxLog(ctx, fmt.Sprintf("No wav to rebuild %v from", out.path))
+			continue
+		}
+
+		// Neither encoder will write over what's there
+		err := s.io.remove(out.path)
+		if err != nil {
+			s.CtxLog(ctx, fmt.Sprintf("Unable to remove %v: %v", out.path, err))
+			continue
+		}
+		s.queueConversion(ctx, id, out.track, out.format == "flac")
+	}
+	if len(fresh) > 0 {
+		s.rescanUnlessWatching(ctx)
+	}
+
+	return corrupt
+}
+
+// storedCorrupt lists the outputs of a release the decode pass has confirmed corrupt, without
+// decoding anything
+func (s *Server) storedCorrupt(id int32) []string {
+	var corrupt []string
+	for _, rip := range s.getRips() {
+		if rip.GetId() != id {
+			continue
+		}
+		for _, track := range rip.GetTracks() {
+			if track.GetMp3().GetFailures() >= confirmDecodes {
+				corrupt = append(corrupt, fmt.Sprintf("%v: %v", track.GetMp3Path(), track.GetMp3().GetCorrupt()))
+			}
+			if track.GetFlac().GetFailures() >= confirmDecodes {
+				corrupt = append(corrupt, fmt.Sprintf("%v: %v", track.GetFlacPath(), track.GetFlac().GetCorrupt()))
+			}
+		}
+	}
+	return corrupt
+}
+
+// pendingDecodes lists the releases with settled outputs waiting on a decode
+func (s *Server) pendingDecodes() []int32 {
+	seen := make(map[int32]bool)
+	var ids []int32
+	for _, rip := range s.getRips() {
+		for _, track := range rip.GetTracks() {
+			pending := (len(track.GetMp3Path()) > 0 && needsDecode(track.GetMp3()) && !s.settling(track.GetMp3Path(), track.GetMp3())) ||
+				(len(track.GetFlacPath()) > 0 && needsDecode(track.GetFlac()) && !s.settling(track.GetFlacPath(), track.GetFlac()))
+			if pending && !seen[rip.GetId()] {
+				seen[rip.GetId()] = true
+				ids = append(ids, rip.GetId())
+			}
+		}
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	return ids
+}
+
+// decodePending checks the outputs of every release waiting on a decode
+func (s *Server) decodePending(ctx context.Context) {
+	for _, id := range s.pendingDecodes() {
+		if ctx.Err() != nil {
+			return
+		}
+		s.checkOutputs(ctx, id)
+	}
+}
+
+// runDecodes checks new and changed outputs in the background, at the scrub rate, so verifying
+// a record never waits on a full decode
+func (s *Server) runDecodes() {
+	for {
+		ctx, cancel := utils.ManualContext("cdprocessor-decode", time.Hour)
+		s.decodePending(ctx)
+		cancel()
+		time.Sleep(decodePoll)
+	}
+}
+
+// recordChecks writes decode results into the index, skipping any file that's changed since
+func (s *Server) recordChecks(checked map[string]*pb.AudioInfo) {
+	s.scanLock.Lock()
+	defer s.scanLock.Unlock()
+
+	same := func(a, b *pb.AudioInfo) bool {
+		return a != nil && a.GetSize() == b.GetSize() && a.GetMtime() == b.GetMtime()
+	}
+
+	var rips []*pb.Rip
+	for _, rip := range s.getRips() {
+		var updated *pb.Rip
+		for i, track := range rip.GetTracks() {
+			mp3, flac := checked[track.GetMp3Path()], checked[track.GetFlacPath()]
+			if !same(mp3, track.GetMp3()) {
+				mp3 = nil
+			}
+			if !same(flac, track.GetFlac()) {
+				flac = nil
+			}
+			if mp3 == nil && flac == nil {
+				continue
+			}
+
+			if updated == nil {
+				updated = proto.Clone(rip).(*pb.Rip)
+			}
+			if mp3 != nil {
+				updated.Tracks[i].Mp3 = mp3
+			}
+			if flac != nil {
+				updated.Tracks[i].Flac = flac
+			}
+		}
+
+		if updated != nil {
+			rips = append(rips, updated)
+		} else {
+			rips = append(rips, rip)
+		}
+	}
+	s.setRips(rips)
+}
@@ -0,0 +1,265 @@
+package main
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/mewkiz/flac"
+	"github.com/mewkiz/flac/frame"
+	"github.com/mewkiz/flac/meta"
+
+	pbcdp "github.com/brotherlogic/cdprocessor/proto"
+)
+
+func writeFlac(t *testing.T, path string, frames int) {
+	f, err := os.Create(path)
+	if err != nil {
+		t.Fatalf("Unable to create %v: %v", path, err)
+	}
+
+	enc, err := flac.NewEncoder(f, &meta.StreamInfo{SampleRate: 44100, NChannels: 2, BitsPerSample: 16})
+	if err != nil {
+		t.Fatalf("Unable to encode: %v", err)
+	}
+	for i := 0; i < frames; i++ {
+		var subframes []*frame.Subframe
+		for c := 0; c < 2; c++ {
+			samples := make([]int32, 4096)
+			for j := range samples {
+				samples[j] = int32((i*4096+j)*(c+3)%20000 - 10000)
+			}
+			subframes = append(subframes, &frame.Subframe{SubHeader: frame.SubHeader{Pred: frame.PredVerbatim}, Samples: samples, NSamples: len(samples)})
+		}
+		err = enc.WriteFrame(&frame.Frame{Header: frame.Header{HasFixedBlockSize: true, BlockSize: 4096, SampleRate: 44100, Channels: frame.ChannelsLR, BitsPerSample: 16}, Subframes: subframes})
+		if err != nil {
+			t.Fatalf("Unable to write frame: %v", err)
+		}
+	}
+	if err := enc.Close(); err != nil {
+		t.Fatalf("Unable to close: %v", err)
+	}
+}
+
+func TestCheckFlac(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "good.flac")
+	writeFlac(t, path, 5)
+	if err := checkFlac(path); err != nil {
+		t.Fatalf("Good flac failed: %v", err)
+	}
+
+	data, _ := os.ReadFile(path)
+
+	// A killed encoder leaves a partial final frame
+	os.WriteFile(filepath.Join(dir, "truncated.flac"), data[:len(data)-1000], 0644)
+	if err := checkFlac(filepath.Join(dir, "truncated.flac")); !isDecodeError(err) {
+		t.Errorf("Truncated flac passed: %v", err)
+	}
+
+	if err := checkFlac(filepath.Join(dir, "missing.flac")); err == nil || isDecodeError(err) {
+		t.Errorf("Missing flac read as damaged: %v", err)
+	}
+
+	flipped := append([]byte{}, data...)
+	flipped[len(flipped)/2] ^= 0x10
+	os.WriteFile(filepath.Join(dir, "flipped.flac"), flipped, 0644)
+	if err := checkFlac(filepath.Join(dir, "flipped.flac")); err == nil {
+		t.Errorf("Damaged flac passed")
+	}
+
+	os.WriteFile(filepath.Join(dir, "empty.flac"), []byte{}, 0644)
+	if err := checkFlac(filepath.Join(dir, "empty.flac")); err == nil {
+		t.Errorf("Empty flac passed")
+	}
+}
+
+func TestCheckMp3(t *testing.T) {
+	dir := t.TempDir()
+	data := makeMp3(50, 0)
+
+	tagged := append(append([]byte{}, data...), []byte("TAG")...)
+	tagged = append(tagged, make([]byte, 125)...)
+
+	broken := append([]byte{}, data...)
+	copy(broken[20+417*30:], []byte{1, 2, 3, 4})
+
+	tests := []struct {
+		name string
+		data []byte
+		good bool
+	}{
+		{"good.mp3", data, true},
+		{"tagged.mp3", tagged, true},
+		{"truncated.mp3", data[:len(data)-200], false},
+		{"broken.mp3", broken, false},
+		{"empty.mp3", []byte{}, false},
+	}
+
+	for _, test := range tests {
+		path := filepath.Join(dir, test.name)
+		os.WriteFile(path, test.data, 0644)
+		if err := checkMp3(path); (err == nil) != test.good || (err != nil && !isDecodeError(err)) {
+			t.Errorf("Bad check of %v: %v", test.name, err)
+		}
+	}
+
+	if err := checkMp3(filepath.Join(dir, "missing.mp3")); err == nil || isDecodeError(err) {
+		t.Errorf("Missing mp3 read as damaged: %v", err)
+	}
+}
+
+func TestCheckOutputs(t *testing.T) {
+	s := InitTestServer(t.TempDir() + "/")
+	tio := &testIo{corrupt: map[string]string{"12/track01.cdda.flac": "decoded 10 samples, expected 20"}}
+	s.io = tio
+	s.setWatching(true)
+	s.setRips([]*pbcdp.Rip{
+		{Id: 12, Path: "12", Tracks: []*pbcdp.Track{
+			{Disk: 1, TrackNumber: 1, WavPath: "12/track01.cdda.wav", Mp3Path: "12/track01.cdda.mp3", FlacPath: "12/track01.cdda.flac",
+				Mp3: &pbcdp.AudioInfo{Size: 10}, Flac: &pbcdp.AudioInfo{Size: 20}},
+		}},
+		{Id: 13, Path: "13", Tracks: []*pbcdp.Track{
+			{Disk: 1, TrackNumber: 1, FlacPath: "13/track01.cdda.flac"},
+		}},
+	})
+
+	// One failed decode isn't enough to act on
+	corrupt := s.checkOutputs(context.Background(), 12)
+	if len(corrupt) != 0 || len(tio.removed) != 0 || s.flacCount != 0 {
+		t.Fatalf("Acted on a single failure: %v, removed %v, %v flacs", corrupt, tio.removed, s.flacCount)
+	}
+	if s.getRips()[0].GetTracks()[0].GetFlac().GetFailures() != 1 {
+		t.Errorf("Failure not recorded: %v", s.getRips()[0])
+	}
+
+	corrupt = s.checkOutputs(context.Background(), 12)
+	if len(corrupt) != 1 || len(tio.removed) != 1 || tio.removed[0] != "12/track01.cdda.flac" || s.flacCount != 1 {
+		t.Fatalf("Bad check: %v, removed %v, %v flacs", corrupt, tio.removed, s.flacCount)
+	}
+
+	track := s.getRips()[0].GetTracks()[0]
+	if track.GetMp3().GetChecked() == 0 || len(track.GetMp3().GetCorrupt()) > 0 || len(track.GetFlac().GetCorrupt()) == 0 || track.GetFlac().GetSize() != 20 {
+		t.Errorf("Checks not recorded: %v", track)
+	}
+	if s.getRips()[1].GetTracks()[0].GetFlac().GetChecked() != 0 {
+		t.Errorf("Other release was checked: %v", s.getRips()[1])
+	}
+
+	// The rebuild is left alone while it's being written
+	corrupt = s.checkOutputs(context.Background(), 12)
+	if len(corrupt) != 0 || len(tio.removed) != 1 {
+		t.Errorf("Checked a flac being rebuilt: %v, removed %v", corrupt, tio.removed)
+	}
+
+	// Known results aren't decoded or acted on again
+	tio.corrupt = nil
+	delete(s.conversions, "12/track01.cdda.flac")
+	corrupt = s.checkOutputs(context.Background(), 12)
+	if len(corrupt) != 1 || len(tio.removed) != 1 {
+		t.Errorf("Bad recheck: %v, removed %v", corrupt, tio.removed)
+	}
+}
+
+func TestCheckOutputsClearsPassingRecheck(t *testing.T) {
+	s := InitTestServer(t.TempDir() + "/")
+	tio := &testIo{corrupt: map[string]string{"12/track01.cdda.flac": "bad frame"}}
+	s.io = tio
+	s.setWatching(true)
+	s.setRips([]*pbcdp.Rip{
+		{Id: 12, Path: "12", Tracks: []*pbcdp.Track{{Disk: 1, TrackNumber: 1, WavPath: "12/track01.cdda.wav", FlacPath: "12/track01.cdda.flac"}}},
+	})
+
+	s.checkOutputs(context.Background(), 12)
+	tio.corrupt = nil
+	corrupt := s.checkOutputs(context.Background(), 12)
+	flac := s.getRips()[0].GetTracks()[0].GetFlac()
+	if len(corrupt) != 0 || len(tio.removed) != 0 || len(flac.GetCorrupt()) > 0 || flac.GetFailures() != 0 {
+		t.Errorf("Passing recheck not cleared: %v, removed %v, %v", corrupt, tio.removed, flac)
+	}
+}
+
+func TestCheckOutputsSkipsUnsettled(t *testing.T) {
+	s := InitTestServer(t.TempDir() + "/")
+	tio := &testIo{
+		corrupt:    map[string]string{"12/track01.cdda.mp3": "lost sync", "12/track01.cdda.flac": "bad frame", "12/track02.cdda.flac": "bad frame"},
+		unreadable: map[string]string{"12/track02.cdda.mp3": "input/output error"},
+	}
+	s.io = tio
+	s.setWatching(true)
+	s.setRips([]*pbcdp.Rip{
+		{Id: 12, Path: "12", Tracks: []*pbcdp.Track{
+			{Disk: 1, TrackNumber: 1, WavPath: "12/track01.cdda.wav", Mp3Path: "12/track01.cdda.mp3", FlacPath: "12/track01.cdda.flac",
+				Mp3: &pbcdp.AudioInfo{Mtime: time.Now().Unix()}},
+			{Disk: 1, TrackNumber: 2, WavPath: "12/track02.cdda.wav", Mp3Path: "12/track02.cdda.mp3", FlacPath: "12/track02.cdda.flac"},
+		}},
+	})
+	s.conversions["12/track01.cdda.flac"] = time.Now()
+	s.conversions["12/track02.cdda.flac"] = time.Now().Add(-conversionTimeout * 2)
+
+	for i := 0; i < 2; i++ {
+		s.checkOutputs(context.Background(), 12)
+	}
+
+	tracks := s.getRips()[0].GetTracks()
+	if tracks[0].GetMp3().GetChecked() != 0 {
+		t.Errorf("Recently written mp3 was checked: %v", tracks[0])
+	}
+	if tracks[0].GetFlac().GetChecked() != 0 {
+		t.Errorf("Flac being converted was checked: %v", tracks[0])
+	}
+	if tracks[1].GetMp3().GetChecked() != 0 || len(tracks[1].GetMp3().GetCorrupt()) > 0 {
+		t.Errorf("Unreadable mp3 was marked: %v", tracks[1])
+	}
+	if tracks[1].GetFlac().GetFailures() != 2 || len(tio.removed) != 1 || tio.removed[0] != "12/track02.cdda.flac" {
+		t.Errorf("Stale conversion held up the check: %v, removed %v", tracks[1], tio.removed)
+	}
+}
+
+func TestCheckOutputsWithoutWav(t *testing.T) {
+	s := InitTestServer(t.TempDir() + "/")
+	tio := &testIo{corrupt: map[string]string{"12/track01.cdda.flac": "bad frame"}}
+	s.io = tio
+	s.setWatching(true)
+	s.setRips([]*pbcdp.Rip{
+		{Id: 12, Path: "12", Tracks: []*pbcdp.Track{{Disk: 1, TrackNumber: 1, FlacPath: "12/track01.cdda.flac"}}},
+	})
+
+	s.checkOutputs(context.Background(), 12)
+	corrupt := s.checkOutputs(context.Background(), 12)
+	if len(corrupt) != 1 || len(tio.removed) != 0 {
+		t.Errorf("Flac without a wav was removed: %v, %v", corrupt, tio.removed)
+	}
+}
+
+func TestDecodePending(t *testing.T) {
+	s := InitTestServer(t.TempDir() + "/")
+	tio := &testIo{corrupt: map[string]string{"12/track01.cdda.flac": "bad frame", "13/track01.cdda.flac": "bad frame"}}
+	s.io = tio
+	s.setWatching(true)
+	s.setRips([]*pbcdp.Rip{
+		{Id: 12, Path: "12", Tracks: []*pbcdp.Track{{Disk: 1, TrackNumber: 1, WavPath: "12/track01.cdda.wav", FlacPath: "12/track01.cdda.flac"}}},
+		{Id: 13, Path: "13", Tracks: []*pbcdp.Track{{Disk: 1, TrackNumber: 1, FlacPath: "13/track01.cdda.flac", Flac: &pbcdp.AudioInfo{Checked: 10}}}},
+		{Id: 14, Path: "14", Tracks: []*pbcdp.Track{{Disk: 1, TrackNumber: 1, FlacPath: "14/track01.cdda.flac", Flac: &pbcdp.AudioInfo{Mtime: time.Now().Unix()}}}},
+	})
+
+	if pending := s.pendingDecodes(); len(pending) != 1 || pending[0] != 12 {
+		t.Fatalf("Bad pending decodes: %v", pending)
+	}
+
+	// Nothing's known to be corrupt until the decode pass has confirmed it
+	if corrupt := s.storedCorrupt(12); len(corrupt) != 0 {
+		t.Errorf("Corrupt before decoding: %v", corrupt)
+	}
+
+	s.decodePending(context.Background())
+	s.decodePending(context.Background())
+	if corrupt := s.storedCorrupt(12); len(corrupt) != 1 || corrupt[0] != "12/track01.cdda.flac: bad frame" {
+		t.Errorf("Bad stored result: %v", corrupt)
+	}
+	if len(tio.removed) != 1 || s.getRips()[1].GetTracks()[0].GetFlac().GetFailures() != 0 {
+		t.Errorf("Decoded the wrong outputs: removed %v, %v", tio.removed, s.getRips()[1])
+	}
+}
@@ -0,0 +1,133 @@
+package main
+
+import (
+	"fmt"
+	"time"
+
+	pb "github.com/brotherlogic/cdprocessor/proto"
+	pbrc "github.com/brotherlogic/recordcollection/proto"
+)
+
+// linkRule decides whether a record should be skipped by the link pipeline; check
+// returns whether the rule fired along with the values it compared
+type linkRule struct {
+	name  string
+	check func(record *pbrc.Record, force bool) (bool, string)
+}
+
+// eligibilityRules are applied by makeLinks, in order, before anything is touched
+var eligibilityRules = []*linkRule{
+	{name: "tape", check: func(record *pbrc.Record, force bool) (bool, string) {
+		return record.GetMetadata().GetFiledUnder() == pbrc.ReleaseMetadata_FILE_TAPE,
+			fmt.Sprintf("filed under %v", record.GetMetadata().GetFiledUnder())
+	}},
+	{name: "not_arrived", check: func(record *pbrc.Record, force bool) (bool, string) {
+		added := time.Unix(record.GetMetadata().GetDateAdded(), 0)
+		return record.GetMetadata().GetDateArrived() == 0 && time.Since(added) < time.Hour*24*365,
+			fmt.Sprintf("arrived %v, added %v", record.GetMetadata().GetDateArrived(), added)
+	}},
+	{name: "not_in_listening_pile", check: func(record *pbrc.Record, force bool) (bool, string) {
+		folder := record.GetRelease().GetFolderId()
+		return folder != 7664293 && folder != 7651472 && folder != 812802,
+			fmt.Sprintf("folder %v, want one of [7664293 7651472 812802]", folder)
+	}},
+	{name: "sold_archive", check: func(record *pbrc.Record, force bool) (bool, string) {
+		return !force && record.GetMetadata().GetCategory() == pbrc.ReleaseMetadata_SOLD_ARCHIVE,
+			fmt.Sprintf("category %v, force %v", record.GetMetadata().GetCategory(), force)
+	}},
+	{name: "unreleased", check: func(record *pbrc.Record, force bool) (bool, string) {
+		val, err := time.Parse("2006-01-02", record.GetRelease().GetReleased())
+		return err == nil && val.After(time.Now()),
+			fmt.Sprintf("released %q", record.GetRelease().GetReleased())
+	}},
+	{name: "boxed", check: func(record *pbrc.Record, force bool) (bool, string) {
+		return record.GetMetadata().GetBoxState() != pbrc.ReleaseMetadata_BOX_UNKNOWN &&
+				record.GetMetadata().GetBoxState() != pbrc.ReleaseMetadata_OUT_OF_BOX,
+			fmt.Sprintf("box state %v", record.GetMetadata().GetBoxState())
+	}},
+	{name: "limbo", check: func(record *pbrc.Record, force bool) (bool, string) {
+		return record.GetRelease().GetFolderId() == 3380098 && record.GetMetadata().GetMoveFolder() == 0,
+			fmt.Sprintf("folder %v, move folder %v", record.GetRelease().GetFolderId(), record.GetMetadata().GetMoveFolder())
+	}},
+}
+
+// formatRules are applied by runLinks once the record has been judged eligible
+var formatRules = []*linkRule{
+	{name: "digital_goal_folder", check: func(record *pbrc.Record, force bool) (bool, string) {
+		goal := record.GetMetadata().GetGoalFolder()
+		return goal == 268147 || goal == 1433217,
+			fmt.Sprintf("goal folder %v, digital are [268147 1433217]", goal)
+	}},
+	{name: "unmatched_format", check: func(record *pbrc.Record, force bool) (bool, string) {
+		goal := record.GetMetadata().GetGoalFolder()
+		if goal == 242018 || goal == 1782105 || goal == 288751 || goal == 2274270 || goal == 565206 {
+			return false, fmt.Sprintf("goal folder %v is a cd folder", goal)
+		}
+
+		var formats []string
+		for _, format := range record.GetRelease().GetFormats() {
+			formats = append(formats, format.GetName())
+			if format.GetName() == "File" || format.GetName() == "CD" || format.GetName() == "CDr" || format.GetName() == "Memory Stick" {
+				return false, fmt.Sprintf("format %v is rippable", format.GetName())
+			}
+		}
+		return true, fmt.Sprintf("goal folder %v, formats %v", goal, formats)
+	}},
+}
+
+func evaluateRules(rules []*linkRule, record *pbrc.Record, force bool) []*pb.LinkRule {
+	var results []*pb.LinkRule
+	for _, rule := range rules {
+		fired, detail := rule.check(record, force)
+		results = append(results, &pb.LinkRule{Name: rule.name, Fired: fired, Detail: detail})
+	}
+	return results
+}
+
+func firstFired(results []*pb.LinkRule) *pb.LinkRule {
+	for _, result := range results {
+		if result.GetFired() {
+			return result
+		}
+	}
+	return nil
+}
+
+// staleForce reports if a record hasn't been processed for a week, which forces a relink
+func staleForce(record *pbrc.Record, config *pb.Config) bool {
+	last := config.GetLastProcessTime()[record.GetRelease().GetInstanceId()]
+	return last > 0 && time.Since(time.Unix(last, 0)) > time.Hour*24*7
+}
+
+// explainLinks works out what makeLinks would do with the record, without doing any of it
+func (s *Server) explainLinks(record *pbrc.Record, force bool, config *pb.Config) *pb.ExplainLinksResponse {
+	resp := &pb.ExplainLinksResponse{Rules: evaluateRules(eligibilityRules, record, force)}
+	if fired := firstFired(resp.GetRules()); fired != nil {
+		resp.Fired = fired.GetName()
+		if fired.GetName() != "tape" {
+			resp.Actions = append(resp.Actions, fmt.Sprintf("set goal folder to %v", record.GetMetadata().GetGoalFolder()))
+		}
+		return resp
+	}
+	resp.Actions = append(resp.Actions, fmt.Sprintf("set goal folder to %v", record.GetMetadata().GetGoalFolder()))
+
+	resp.Force = force || staleForce(record, config)
+	runRules := evaluateRules(formatRules, record, resp.Force)
+	resp.Rules = append(resp.Rules, runRules...)
+	if fired := firstFired(runRules); fired != nil {
+		resp.Fired = fired.GetName()
+	} else if resp.Force || len(record.GetMetadata().GetCdPath()) == 0 {
+		trackSet := TrackExtract(record.GetRelease(), record.GetMetadata().GetGoalFolder() == 565206)
+		for _, track := range rippableTracks(trackSet) {
+			resp.Actions = append(resp.Actions,
+				fmt.Sprintf("link disk %v track %v from %v", track.Disk, track.Position, s.trackPath(track, record)),
+				fmt.Sprintf("tag disk %v track %v as %q", track.Disk, track.Position, GetTitle(track)))
+		}
+		resp.Actions = append(resp.Actions, fmt.Sprintf("update record cd path to %v%v", s.flacdir, record.GetRelease().GetId()))
+	} else {
+		resp.Actions = append(resp.Actions, fmt.Sprintf("verify files in %v", record.GetMetadata().GetCdPath()))
+	}
+
+	resp.Actions = append(resp.Actions, "set last process time", "save config")
+	return resp
+}
@@ -0,0 +1,50 @@
+package main
+
+import (
+	"context"
+	"testing"
+
+	pb "github.com/brotherlogic/cdprocessor/proto"
+	pbgd "github.com/brotherlogic/godiscogs/proto"
+	pbrc "github.com/brotherlogic/recordcollection/proto"
+)
+
+func TestExplainLinks(t *testing.T) {
+	s := InitTestServer("testdata/")
+	s.save(context.Background(), &pb.Config{})
+
+	resp, err := s.ExplainLinks(context.Background(), &pb.ExplainLinksRequest{InstanceId: 12345})
+	if err != nil {
+		t.Fatalf("Bad explain: %v", err)
+	}
+
+	if len(resp.GetFired()) > 0 || len(resp.GetActions()) == 0 {
+		t.Errorf("Record should be linked: %v", resp)
+	}
+
+	if tr, ok := s.ripper.(*testRipper); !ok || len(tr.commands) > 0 {
+		t.Errorf("Explain ran commands: %v", s.ripper)
+	}
+}
+
+func TestExplainSkips(t *testing.T) {
+	s := InitTestServer("testdata/")
+
+	record := &pbrc.Record{Release: &pbgd.Release{InstanceId: 12, FolderId: 12}, Metadata: &pbrc.ReleaseMetadata{}}
+	resp := s.explainLinks(record, false, &pb.Config{})
+	if resp.GetFired() != "not_in_listening_pile" {
+		t.Errorf("Wrong rule fired: %v", resp)
+	}
+
+	record = &pbrc.Record{Release: &pbgd.Release{InstanceId: 12, FolderId: 812802, Formats: []*pbgd.Format{&pbgd.Format{Name: "Vinyl"}}}, Metadata: &pbrc.ReleaseMetadata{}}
+	resp = s.explainLinks(record, false, &pb.Config{})
+	if resp.GetFired() != "unmatched_format" {
+		t.Errorf("Wrong rule fired: %v", resp)
+	}
+
+	record = &pbrc.Record{Release: &pbgd.Release{InstanceId: 12, FolderId: 812802}, Metadata: &pbrc.ReleaseMetadata{Category: pbrc.ReleaseMetadata_SOLD_ARCHIVE}}
+	resp = s.explainLinks(record, true, &pb.Config{})
+	if resp.GetFired() == "sold_archive" {
+		t.Errorf("Forced sold archive was skipped: %v", resp)
+	}
+}
@@ -0,0 +1,154 @@
+package main
+
+import (
+	"fmt"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	pb "github.com/brotherlogic/cdprocessor/proto"
+)
+
+// migration upgrades the stored config to the given schema version
+type migration struct {
+	version int32
+	name    string
+	run     func(ctx context.Context, s *Server, config *pb.Config) error
+}
+
+// migrations are run in order on load; only ever append to this list
+var migrations = []*migration{
+	{version: 1, name: "key by instance id", run: keyByInstance},
+	{version: 2, name: "backfill outstanding", run: backfillOutstanding},
+}
+
+func latestSchema() int32 {
+	return migrations[len(migrations)-1].version
+}
+
+// migrate brings the config up to the latest schema, reporting if anything was run
+func (s *Server) migrate(ctx context.Context, config *pb.Config) (bool, error) {
+	ran := false
+	for _, m := range migrations {
+		if m.version > config.GetSchemaVersion() {
+			s.CtxLog(ctx, fmt.Sprintf("Migrating config from %v to %v: %v", config.GetSchemaVersion(), m.version, m.name))
+			err := m.run(ctx, s, config)
+			if err != nil {
+				return ran, fmt.Errorf("migration %v (%v) failed: %w", m.version, m.name, err)
+			}
+			config.SchemaVersion = m.version
+			ran = true
+		}
+	}
+	return ran, nil
+}
+
+// keyByInstance moves the maps keyed by release id over to instance id, so that
+// records we own more than once each get their own entry
+func keyByInstance(ctx context.Context, s *Server, config *pb.Config) error {
+	instances := make(map[int32][]int32)
+	lookup := func(releaseID int32) ([]int32, error) {
+		if ids, ok := instances[releaseID]; ok {
+			return ids, nil
+		}
+		ids, err := s.rc.getInstanceIds(ctx, releaseID)
+		if err != nil {
+			return nil, err
+		}
+		if len(ids) == 0 {
+			s.CtxLog(ctx, fmt.Sprintf("Dropping config for %v, it has no instances", releaseID))
+		}
+		instances[releaseID] = ids
+		return ids, nil
+	}
+
+	goalFolder := make(map[int32]int32)
+	for releaseID, folder := range config.GetGoalFolder() {
+		ids, err := lookup(releaseID)
+		if err != nil {
+			return err
+		}
+		for _, id := range ids {
+			goalFolder[id] = folder
+		}
+	}
+
+	lastRipTime := make(map[int32]int64)
+	for releaseID, t := range config.GetLastRipTime() {
+		ids, err := lookup(releaseID)
+		if err != nil {
+			return err
+		}
+		for _, id := range ids {
+			lastRipTime[id] = t
+		}
+	}
+
+	// An issue was raised for one instance, so only that instance keeps it
+	issueMapping := make(map[int32]int32)
+	outstanding := make(map[int32]*pb.Outstanding)
+	for releaseID, issue := range config.GetIssueMapping() {
+		ids, err := lookup(releaseID)
+		if err != nil {
+			return err
+		}
+		if len(ids) == 0 {
+			continue
+		}
+
+		entry := config.GetOutstanding()[releaseID]
+		owner := ids[0]
+		for _, id := range ids {
+			if id == entry.GetInstanceId() {
+				owner = id
+			}
+		}
+		if len(ids) > 1 && owner != entry.GetInstanceId() {
+			s.CtxLog(ctx, fmt.Sprintf("Unable to tell which of %v owns issue %v, giving it to %v", ids, issue, owner))
+		}
+
+		issueMapping[owner] = issue
+		if entry != nil {
+			nentry := proto.Clone(entry).(*pb.Outstanding)
+			nentry.ReleaseId = releaseID
+			nentry.InstanceId = owner
+			outstanding[owner] = nentry
+		}
+	}
+
+	config.GoalFolder = goalFolder
+	config.IssueMapping = issueMapping
+	config.LastRipTime = lastRipTime
+	config.Outstanding = outstanding
+	return nil
+}
+
+// backfillOutstanding fills in the details of issues which were opened before we
+// kept them, so they can be listed along with the rest
+func backfillOutstanding(ctx context.Context, s *Server, config *pb.Config) error {
+	if config.Outstanding == nil {
+		config.Outstanding = make(map[int32]*pb.Outstanding)
+	}
+
+	for id, issue := range config.GetIssueMapping() {
+		if _, ok := config.GetOutstanding()[id]; ok {
+			continue
+		}
+
+		entry := &pb.Outstanding{InstanceId: id, Issue: issue}
+		record, err := s.getter.getRecord(ctx, id)
+		if err != nil {
+			if status.Convert(err).Code() != codes.NotFound {
+				return err
+			}
+			s.CtxLog(ctx, fmt.Sprintf("Unable to backfill %v, it has left the collection", id))
+		} else {
+			entry.ReleaseId = record.GetRelease().GetId()
+			entry.Title = record.GetRelease().GetTitle()
+		}
+		config.Outstanding[id] = entry
+	}
+	return nil
+}
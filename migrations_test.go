@@ -0,0 +1,94 @@
+package main
+
+import (
+	"context"
+	"testing"
+
+	pb "github.com/brotherlogic/cdprocessor/proto"
+)
+
+func TestMigrateToInstance(t *testing.T) {
+	s := InitTestServer("testdata/")
+	s.save(context.Background(), &pb.Config{
+		GoalFolder:      map[int32]int32{100: 5},
+		IssueMapping:    map[int32]int32{100: 7, 200: 8},
+		LastRipTime:     map[int32]int64{100: 9},
+		LastProcessTime: map[int32]int64{200: 1},
+		Outstanding:     map[int32]*pb.Outstanding{100: &pb.Outstanding{Issue: 7}, 200: &pb.Outstanding{Issue: 8, InstanceId: 201}},
+	})
+
+	config, err := s.load(context.Background())
+	if err != nil {
+		t.Fatalf("Bad load: %v", err)
+	}
+
+	if config.GetSchemaVersion() != latestSchema() {
+		t.Errorf("Schema was not updated: %v", config)
+	}
+
+	if config.GetGoalFolder()[101] != 5 || config.GetIssueMapping()[100] != 7 || config.GetLastRipTime()[101] != 9 || config.GetLastProcessTime()[200] != 1 {
+		t.Errorf("Bad migration: %v", config)
+	}
+
+	// Issues stay with the instance they were raised for
+	if config.GetIssueMapping()[101] != 0 || config.GetIssueMapping()[200] != 0 || config.GetIssueMapping()[201] != 8 {
+		t.Errorf("Issue was shared between instances: %v", config.GetIssueMapping())
+	}
+	if len(config.GetOutstanding()) != 2 || config.GetOutstanding()[100].GetReleaseId() != 100 || config.GetOutstanding()[201].GetReleaseId() != 200 || config.GetOutstanding()[201].GetInstanceId() != 201 {
+		t.Errorf("Bad outstanding migration: %v", config.GetOutstanding())
+	}
+
+	// A second load should leave the migrated config alone
+	s.rc = &testRc{failGet: true}
+	config, err = s.load(context.Background())
+	if err != nil || len(config.GetGoalFolder()) != 2 {
+		t.Errorf("Migration ran twice: %v -> %v", config, err)
+	}
+}
+
+func TestMigrateFail(t *testing.T) {
+	s := InitTestServer("testdata/")
+	s.rc = &testRc{failGet: true}
+	s.save(context.Background(), &pb.Config{GoalFolder: map[int32]int32{100: 5}})
+
+	config, err := s.load(context.Background())
+	if err == nil {
+		t.Errorf("Failed migration did not fail load: %v", config)
+	}
+}
+
+func TestBackfillOutstanding(t *testing.T) {
+	s := InitTestServer("testdata/")
+	s.getter = &testGetter{missing: map[int32]bool{13: true}}
+	s.save(context.Background(), &pb.Config{
+		SchemaVersion: 1,
+		IssueMapping:  map[int32]int32{12: 7, 13: 8, 14: 9},
+		Outstanding:   map[int32]*pb.Outstanding{14: &pb.Outstanding{InstanceId: 14, ReleaseId: 140, Title: "Known", Issue: 9}},
+	})
+
+	config, err := s.load(context.Background())
+	if err != nil {
+		t.Fatalf("Bad load: %v", err)
+	}
+
+	if config.GetOutstanding()[12].GetReleaseId() != 12 || config.GetOutstanding()[12].GetIssue() != 7 {
+		t.Errorf("Legacy issue was not backfilled: %v", config.GetOutstanding()[12])
+	}
+	if config.GetOutstanding()[13].GetInstanceId() != 13 || config.GetOutstanding()[13].GetIssue() != 8 {
+		t.Errorf("Issue for a departed record was not kept: %v", config.GetOutstanding()[13])
+	}
+	if config.GetOutstanding()[14].GetTitle() != "Known" {
+		t.Errorf("Existing entry was replaced: %v", config.GetOutstanding()[14])
+	}
+}
+
+func TestBackfillOutstandingFail(t *testing.T) {
+	s := InitTestServer("testdata/")
+	s.getter = &testGetter{fail: true}
+	s.save(context.Background(), &pb.Config{SchemaVersion: 1, IssueMapping: map[int32]int32{12: 7}})
+
+	config, err := s.load(context.Background())
+	if err == nil {
+		t.Errorf("Failed backfill did not fail load: %v", config)
+	}
+}
@@ -21,11 +21,74 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type QueueEntry_Reason int32
+
+const (
+	QueueEntry_UNKNOWN             QueueEntry_Reason = 0
+	QueueEntry_MISSING_TRACK       QueueEntry_Reason = 1
+	QueueEntry_FILE_COUNT_MISMATCH QueueEntry_Reason = 2
+	QueueEntry_NEVER_LINKED        QueueEntry_Reason = 3
+	QueueEntry_MANUAL              QueueEntry_Reason = 4
+	QueueEntry_CORRUPT_OUTPUT      QueueEntry_Reason = 6
+)
+
+// Enum value maps for QueueEntry_Reason.
+var (
+	QueueEntry_Reason_name = map[int32]string{
+		0: "UNKNOWN",
+		1: "MISSING_TRACK",
+		2: "FILE_COUNT_MISMATCH",
+		3: "NEVER_LINKED",
+		4: "MANUAL",
+		6: "CORRUPT_OUTPUT",
+	}
+	QueueEntry_Reason_value = map[string]int32{
+		"UNKNOWN":             0,
+		"MISSING_TRACK":       1,
+		"FILE_COUNT_MISMATCH": 2,
+		"NEVER_LINKED":        3,
+		"MANUAL":              4,
+		"CORRUPT_OUTPUT":      6,
+	}
+)
+
+func (x QueueEntry_Reason) Enum() *QueueEntry_Reason {
+	p := new(QueueEntry_Reason)
+	*p = x
+	return p
+}
+
+func (x QueueEntry_Reason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (QueueEntry_Reason) Descriptor() protoreflect.EnumDescriptor {
+	return file_cdprocessor_proto_enumTypes[0].Descriptor()
+}
+
+func (QueueEntry_Reason) Type() protoreflect.EnumType {
+	return &file_cdprocessor_proto_enumTypes[0]
+}
+
+func (x QueueEntry_Reason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use QueueEntry_Reason.Descriptor instead.
+func (QueueEntry_Reason) EnumDescriptor() ([]byte, []int) {
+	return file_cdprocessor_proto_rawDescGZIP(), []int{5, 0}
+}
+
 type ForceRequest_ForceType int32
 
 const (
 	ForceRequest_UNKNOWN        ForceRequest_ForceType = 0
 	ForceRequest_RECREATE_LINKS ForceRequest_ForceType = 1
+	ForceRequest_RECONVERT_MP3  ForceRequest_ForceType = 2
+	ForceRequest_RECONVERT_FLAC ForceRequest_ForceType = 3
+	ForceRequest_RETAG_ONLY     ForceRequest_ForceType = 4
+	ForceRequest_VERIFY_ONLY    ForceRequest_ForceType = 5
+	ForceRequest_RESCAN_DISK    ForceRequest_ForceType = 6
 )
 
 // Enum value maps for ForceRequest_ForceType.
@@ -33,10 +96,20 @@ var (
 	ForceRequest_ForceType_name = map[int32]string{
 		0: "UNKNOWN",
 		1: "RECREATE_LINKS",
+		2: "RECONVERT_MP3",
+		3: "RECONVERT_FLAC",
+		4: "RETAG_ONLY",
+		5: "VERIFY_ONLY",
+		6: "RESCAN_DISK",
 	}
 	ForceRequest_ForceType_value = map[string]int32{
 		"UNKNOWN":        0,
 		"RECREATE_LINKS": 1,
+		"RECONVERT_MP3":  2,
+		"RECONVERT_FLAC": 3,
+		"RETAG_ONLY":     4,
+		"VERIFY_ONLY":    5,
+		"RESCAN_DISK":    6,
 	}
 )
 
@@ -51,11 +124,11 @@ func (x ForceRequest_ForceType) String() string {
 }
 
 func (ForceRequest_ForceType) Descriptor() protoreflect.EnumDescriptor {
-	return file_cdprocessor_proto_enumTypes[0].Descriptor()
+	return file_cdprocessor_proto_enumTypes[1].Descriptor()
 }
 
 func (ForceRequest_ForceType) Type() protoreflect.EnumType {
-	return &file_cdprocessor_proto_enumTypes[0]
+	return &file_cdprocessor_proto_enumTypes[1]
 }
 
 func (x ForceRequest_ForceType) Number() protoreflect.EnumNumber {
@@ -64,7 +137,205 @@ func (x ForceRequest_ForceType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use ForceRequest_ForceType.Descriptor instead.
 func (ForceRequest_ForceType) EnumDescriptor() ([]byte, []int) {
-	return file_cdprocessor_proto_rawDescGZIP(), []int{7, 0}
+	return file_cdprocessor_proto_rawDescGZIP(), []int{15, 0}
+}
+
+type RipEvent_EventType int32
+
+const (
+	RipEvent_UNKNOWN       RipEvent_EventType = 0
+	RipEvent_RIP_FOUND     RipEvent_EventType = 1
+	RipEvent_MP3_QUEUED    RipEvent_EventType = 2
+	RipEvent_FLAC_QUEUED   RipEvent_EventType = 3
+	RipEvent_TRACK_LINKED  RipEvent_EventType = 4
+	RipEvent_ISSUE_OPENED  RipEvent_EventType = 5
+	RipEvent_ISSUE_CLOSED  RipEvent_EventType = 6
+	RipEvent_CORRUPT_FOUND RipEvent_EventType = 7
+)
+
+// Enum value maps for RipEvent_EventType.
+var (
+	RipEvent_EventType_name = map[int32]string{
+		0: "UNKNOWN",
+		1: "RIP_FOUND",
+		2: "MP3_QUEUED",
+		3: "FLAC_QUEUED",
+		4: "TRACK_LINKED",
+		5: "ISSUE_OPENED",
+		6: "ISSUE_CLOSED",
+		7: "CORRUPT_FOUND",
+	}
+	RipEvent_EventType_value = map[string]int32{
+		"UNKNOWN":       0,
+		"RIP_FOUND":     1,
+		"MP3_QUEUED":    2,
+		"FLAC_QUEUED":   3,
+		"TRACK_LINKED":  4,
+		"ISSUE_OPENED":  5,
+		"ISSUE_CLOSED":  6,
+		"CORRUPT_FOUND": 7,
+	}
+)
+
+func (x RipEvent_EventType) Enum() *RipEvent_EventType {
+	p := new(RipEvent_EventType)
+	*p = x
+	return p
+}
+
+func (x RipEvent_EventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RipEvent_EventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_cdprocessor_proto_enumTypes[2].Descriptor()
+}
+
+func (RipEvent_EventType) Type() protoreflect.EnumType {
+	return &file_cdprocessor_proto_enumTypes[2]
+}
+
+func (x RipEvent_EventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RipEvent_EventType.Descriptor instead.
+func (RipEvent_EventType) EnumDescriptor() ([]byte, []int) {
+	return file_cdprocessor_proto_rawDescGZIP(), []int{19, 0}
+}
+
+type HistoryEntry_Type int32
+
+const (
+	HistoryEntry_UNKNOWN           HistoryEntry_Type = 0
+	HistoryEntry_RIP_FOUND         HistoryEntry_Type = 1
+	HistoryEntry_MP3_QUEUED        HistoryEntry_Type = 2
+	HistoryEntry_FLAC_QUEUED       HistoryEntry_Type = 3
+	HistoryEntry_LINKS_BUILT       HistoryEntry_Type = 4
+	HistoryEntry_VERIFY_PASSED     HistoryEntry_Type = 5
+	HistoryEntry_VERIFY_FAILED     HistoryEntry_Type = 6
+	HistoryEntry_ISSUE_OPENED      HistoryEntry_Type = 7
+	HistoryEntry_ISSUE_CLOSED      HistoryEntry_Type = 8
+	HistoryEntry_FORCED            HistoryEntry_Type = 9
+	HistoryEntry_FAILED            HistoryEntry_Type = 10
+	HistoryEntry_CORRUPT_FOUND     HistoryEntry_Type = 11
+	HistoryEntry_CHECKSUM_MISMATCH HistoryEntry_Type = 12
+)
+
+// Enum value maps for HistoryEntry_Type.
+var (
+	HistoryEntry_Type_name = map[int32]string{
+		0:  "UNKNOWN",
+		1:  "RIP_FOUND",
+		2:  "MP3_QUEUED",
+		3:  "FLAC_QUEUED",
+		4:  "LINKS_BUILT",
+		5:  "VERIFY_PASSED",
+		6:  "VERIFY_FAILED",
+		7:  "ISSUE_OPENED",
+		8:  "ISSUE_CLOSED",
+		9:  "FORCED",
+		10: "FAILED",
+		11: "CORRUPT_FOUND",
+		12: "CHECKSUM_MISMATCH",
+	}
+	HistoryEntry_Type_value = map[string]int32{
+		"UNKNOWN":           0,
+		"RIP_FOUND":         1,
+		"MP3_QUEUED":        2,
+		"FLAC_QUEUED":       3,
+		"LINKS_BUILT":       4,
+		"VERIFY_PASSED":     5,
+		"VERIFY_FAILED":     6,
+		"ISSUE_OPENED":      7,
+		"ISSUE_CLOSED":      8,
+		"FORCED":            9,
+		"FAILED":            10,
+		"CORRUPT_FOUND":     11,
+		"CHECKSUM_MISMATCH": 12,
+	}
+)
+
+func (x HistoryEntry_Type) Enum() *HistoryEntry_Type {
+	p := new(HistoryEntry_Type)
+	*p = x
+	return p
+}
+
+func (x HistoryEntry_Type) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (HistoryEntry_Type) Descriptor() protoreflect.EnumDescriptor {
+	return file_cdprocessor_proto_enumTypes[3].Descriptor()
+}
+
+func (HistoryEntry_Type) Type() protoreflect.EnumType {
+	return &file_cdprocessor_proto_enumTypes[3]
+}
+
+func (x HistoryEntry_Type) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use HistoryEntry_Type.Descriptor instead.
+func (HistoryEntry_Type) EnumDescriptor() ([]byte, []int) {
+	return file_cdprocessor_proto_rawDescGZIP(), []int{43, 0}
+}
+
+type RipProblem_Kind int32
+
+const (
+	RipProblem_UNKNOWN RipProblem_Kind = 0
+	// The id doesn't belong to a record we still own
+	RipProblem_ORPHAN RipProblem_Kind = 1
+	// The directory is named for the instance id, not the release id
+	RipProblem_INSTANCE_ID RipProblem_Kind = 2
+	// More than one directory holds the same disk of the same release
+	RipProblem_DUPLICATE RipProblem_Kind = 3
+)
+
+// Enum value maps for RipProblem_Kind.
+var (
+	RipProblem_Kind_name = map[int32]string{
+		0: "UNKNOWN",
+		1: "ORPHAN",
+		2: "INSTANCE_ID",
+		3: "DUPLICATE",
+	}
+	RipProblem_Kind_value = map[string]int32{
+		"UNKNOWN":     0,
+		"ORPHAN":      1,
+		"INSTANCE_ID": 2,
+		"DUPLICATE":   3,
+	}
+)
+
+func (x RipProblem_Kind) Enum() *RipProblem_Kind {
+	p := new(RipProblem_Kind)
+	*p = x
+	return p
+}
+
+func (x RipProblem_Kind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RipProblem_Kind) Descriptor() protoreflect.EnumDescriptor {
+	return file_cdprocessor_proto_enumTypes[4].Descriptor()
+}
+
+func (RipProblem_Kind) Type() protoreflect.EnumType {
+	return &file_cdprocessor_proto_enumTypes[4]
+}
+
+func (x RipProblem_Kind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RipProblem_Kind.Descriptor instead.
+func (RipProblem_Kind) EnumDescriptor() ([]byte, []int) {
+	return file_cdprocessor_proto_rawDescGZIP(), []int{52, 0}
 }
 
 type Config struct {
@@ -72,11 +343,20 @@ type Config struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	LastProcessTime map[int32]int64 `protobuf:"bytes,1,rep,name=last_process_time,json=lastProcessTime,proto3" json:"last_process_time,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
-	IssueMapping    map[int32]int32 `protobuf:"bytes,2,rep,name=issue_mapping,json=issueMapping,proto3" json:"issue_mapping,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
-	LastRipTime     map[int32]int64 `protobuf:"bytes,3,rep,name=last_rip_time,json=lastRipTime,proto3" json:"last_rip_time,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
-	GoalFolder      map[int32]int32 `protobuf:"bytes,4,rep,name=goal_folder,json=goalFolder,proto3" json:"goal_folder,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
-	ToGo            []int32         `protobuf:"varint,5,rep,packed,name=to_go,json=toGo,proto3" json:"to_go,omitempty"`
+	// All maps are keyed by instance id (from schema version 1)
+	LastProcessTime map[int32]int64        `protobuf:"bytes,1,rep,name=last_process_time,json=lastProcessTime,proto3" json:"last_process_time,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	IssueMapping    map[int32]int32        `protobuf:"bytes,2,rep,name=issue_mapping,json=issueMapping,proto3" json:"issue_mapping,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	LastRipTime     map[int32]int64        `protobuf:"bytes,3,rep,name=last_rip_time,json=lastRipTime,proto3" json:"last_rip_time,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	GoalFolder      map[int32]int32        `protobuf:"bytes,4,rep,name=goal_folder,json=goalFolder,proto3" json:"goal_folder,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	ToGo            []int32                `protobuf:"varint,5,rep,packed,name=to_go,json=toGo,proto3" json:"to_go,omitempty"`
+	ToGoDetail      map[int32]*QueueEntry  `protobuf:"bytes,6,rep,name=to_go_detail,json=toGoDetail,proto3" json:"to_go_detail,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Outstanding     map[int32]*Outstanding `protobuf:"bytes,7,rep,name=outstanding,proto3" json:"outstanding,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	SchemaVersion   int32                  `protobuf:"varint,8,opt,name=schema_version,json=schemaVersion,proto3" json:"schema_version,omitempty"`
+	LastCompaction  *CompactionReport      `protobuf:"bytes,9,opt,name=last_compaction,json=lastCompaction,proto3" json:"last_compaction,omitempty"`
+	// Bumped on every save, a save fails if this has moved on since the load
+	Revision int64 `protobuf:"varint,10,opt,name=revision,proto3" json:"revision,omitempty"`
+	// Records taken off the queue by hand, which failed links don't put back
+	Suppressed map[int32]*QueueSuppression `protobuf:"bytes,11,rep,name=suppressed,proto3" json:"suppressed,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
 func (x *Config) Reset() {
@@ -146,14 +426,60 @@ func (x *Config) GetToGo() []int32 {
 	return nil
 }
 
-type GetRippedRequest struct {
+func (x *Config) GetToGoDetail() map[int32]*QueueEntry {
+	if x != nil {
+		return x.ToGoDetail
+	}
+	return nil
+}
+
+func (x *Config) GetOutstanding() map[int32]*Outstanding {
+	if x != nil {
+		return x.Outstanding
+	}
+	return nil
+}
+
+func (x *Config) GetSchemaVersion() int32 {
+	if x != nil {
+		return x.SchemaVersion
+	}
+	return 0
+}
+
+func (x *Config) GetLastCompaction() *CompactionReport {
+	if x != nil {
+		return x.LastCompaction
+	}
+	return nil
+}
+
+func (x *Config) GetRevision() int64 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+func (x *Config) GetSuppressed() map[int32]*QueueSuppression {
+	if x != nil {
+		return x.Suppressed
+	}
+	return nil
+}
+
+type QueueSuppression struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	InstanceId int32  `protobuf:"varint,1,opt,name=instance_id,json=instanceId,proto3" json:"instance_id,omitempty"`
+	Reason     string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	Time       int64  `protobuf:"varint,3,opt,name=time,proto3" json:"time,omitempty"`
 }
 
-func (x *GetRippedRequest) Reset() {
-	*x = GetRippedRequest{}
+func (x *QueueSuppression) Reset() {
+	*x = QueueSuppression{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_cdprocessor_proto_msgTypes[1]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -161,13 +487,13 @@ func (x *GetRippedRequest) Reset() {
 	}
 }
 
-func (x *GetRippedRequest) String() string {
+func (x *QueueSuppression) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetRippedRequest) ProtoMessage() {}
+func (*QueueSuppression) ProtoMessage() {}
 
-func (x *GetRippedRequest) ProtoReflect() protoreflect.Message {
+func (x *QueueSuppression) ProtoReflect() protoreflect.Message {
 	mi := &file_cdprocessor_proto_msgTypes[1]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -179,25 +505,45 @@ func (x *GetRippedRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetRippedRequest.ProtoReflect.Descriptor instead.
-func (*GetRippedRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use QueueSuppression.ProtoReflect.Descriptor instead.
+func (*QueueSuppression) Descriptor() ([]byte, []int) {
 	return file_cdprocessor_proto_rawDescGZIP(), []int{1}
 }
 
-type Track struct {
+func (x *QueueSuppression) GetInstanceId() int32 {
+	if x != nil {
+		return x.InstanceId
+	}
+	return 0
+}
+
+func (x *QueueSuppression) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *QueueSuppression) GetTime() int64 {
+	if x != nil {
+		return x.Time
+	}
+	return 0
+}
+
+type CompactedRecord struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Disk        int32  `protobuf:"varint,5,opt,name=disk,proto3" json:"disk,omitempty"`
-	TrackNumber int32  `protobuf:"varint,1,opt,name=track_number,json=trackNumber,proto3" json:"track_number,omitempty"`
-	WavPath     string `protobuf:"bytes,2,opt,name=wav_path,json=wavPath,proto3" json:"wav_path,omitempty"`
-	Mp3Path     string `protobuf:"bytes,3,opt,name=mp3_path,json=mp3Path,proto3" json:"mp3_path,omitempty"`
-	FlacPath    string `protobuf:"bytes,4,opt,name=flac_path,json=flacPath,proto3" json:"flac_path,omitempty"`
+	InstanceId int32  `protobuf:"varint,1,opt,name=instance_id,json=instanceId,proto3" json:"instance_id,omitempty"`
+	Reason     string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	// The config fields the record was removed from
+	Fields []string `protobuf:"bytes,3,rep,name=fields,proto3" json:"fields,omitempty"`
 }
 
-func (x *Track) Reset() {
-	*x = Track{}
+func (x *CompactedRecord) Reset() {
+	*x = CompactedRecord{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_cdprocessor_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -205,13 +551,13 @@ func (x *Track) Reset() {
 	}
 }
 
-func (x *Track) String() string {
+func (x *CompactedRecord) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Track) ProtoMessage() {}
+func (*CompactedRecord) ProtoMessage() {}
 
-func (x *Track) ProtoReflect() protoreflect.Message {
+func (x *CompactedRecord) ProtoReflect() protoreflect.Message {
 	mi := &file_cdprocessor_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -223,58 +569,47 @@ func (x *Track) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Track.ProtoReflect.Descriptor instead.
-func (*Track) Descriptor() ([]byte, []int) {
+// Deprecated: Use CompactedRecord.ProtoReflect.Descriptor instead.
+func (*CompactedRecord) Descriptor() ([]byte, []int) {
 	return file_cdprocessor_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *Track) GetDisk() int32 {
-	if x != nil {
-		return x.Disk
-	}
-	return 0
-}
-
-func (x *Track) GetTrackNumber() int32 {
+func (x *CompactedRecord) GetInstanceId() int32 {
 	if x != nil {
-		return x.TrackNumber
+		return x.InstanceId
 	}
 	return 0
 }
 
-func (x *Track) GetWavPath() string {
-	if x != nil {
-		return x.WavPath
-	}
-	return ""
-}
-
-func (x *Track) GetMp3Path() string {
+func (x *CompactedRecord) GetReason() string {
 	if x != nil {
-		return x.Mp3Path
+		return x.Reason
 	}
 	return ""
 }
 
-func (x *Track) GetFlacPath() string {
+func (x *CompactedRecord) GetFields() []string {
 	if x != nil {
-		return x.FlacPath
+		return x.Fields
 	}
-	return ""
+	return nil
 }
 
-type Rip struct {
+type CompactionReport struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id     int32    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Path   string   `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
-	Tracks []*Track `protobuf:"bytes,3,rep,name=tracks,proto3" json:"tracks,omitempty"`
+	RunTime int64              `protobuf:"varint,1,opt,name=run_time,json=runTime,proto3" json:"run_time,omitempty"`
+	Checked int32              `protobuf:"varint,2,opt,name=checked,proto3" json:"checked,omitempty"`
+	Removed []*CompactedRecord `protobuf:"bytes,3,rep,name=removed,proto3" json:"removed,omitempty"`
+	DryRun  bool               `protobuf:"varint,4,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	// Records we couldn't look up, with the error as the reason; they're left for next time
+	Skipped []*CompactedRecord `protobuf:"bytes,5,rep,name=skipped,proto3" json:"skipped,omitempty"`
 }
 
-func (x *Rip) Reset() {
-	*x = Rip{}
+func (x *CompactionReport) Reset() {
+	*x = CompactionReport{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_cdprocessor_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -282,13 +617,13 @@ func (x *Rip) Reset() {
 	}
 }
 
-func (x *Rip) String() string {
+func (x *CompactionReport) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Rip) ProtoMessage() {}
+func (*CompactionReport) ProtoMessage() {}
 
-func (x *Rip) ProtoReflect() protoreflect.Message {
+func (x *CompactionReport) ProtoReflect() protoreflect.Message {
 	mi := &file_cdprocessor_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -300,42 +635,63 @@ func (x *Rip) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Rip.ProtoReflect.Descriptor instead.
-func (*Rip) Descriptor() ([]byte, []int) {
+// Deprecated: Use CompactionReport.ProtoReflect.Descriptor instead.
+func (*CompactionReport) Descriptor() ([]byte, []int) {
 	return file_cdprocessor_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *Rip) GetId() int32 {
+func (x *CompactionReport) GetRunTime() int64 {
 	if x != nil {
-		return x.Id
+		return x.RunTime
 	}
 	return 0
 }
 
-func (x *Rip) GetPath() string {
+func (x *CompactionReport) GetChecked() int32 {
 	if x != nil {
-		return x.Path
+		return x.Checked
 	}
-	return ""
+	return 0
 }
 
-func (x *Rip) GetTracks() []*Track {
+func (x *CompactionReport) GetRemoved() []*CompactedRecord {
 	if x != nil {
-		return x.Tracks
+		return x.Removed
 	}
 	return nil
 }
 
-type GetRippedResponse struct {
+func (x *CompactionReport) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *CompactionReport) GetSkipped() []*CompactedRecord {
+	if x != nil {
+		return x.Skipped
+	}
+	return nil
+}
+
+type Outstanding struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Ripped []*Rip `protobuf:"bytes,1,rep,name=ripped,proto3" json:"ripped,omitempty"`
+	ReleaseId  int32  `protobuf:"varint,1,opt,name=release_id,json=releaseId,proto3" json:"release_id,omitempty"`
+	InstanceId int32  `protobuf:"varint,2,opt,name=instance_id,json=instanceId,proto3" json:"instance_id,omitempty"`
+	Title      string `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Issue      int32  `protobuf:"varint,4,opt,name=issue,proto3" json:"issue,omitempty"`
+	// When the issue was opened
+	Opened int64 `protobuf:"varint,5,opt,name=opened,proto3" json:"opened,omitempty"`
+	// The verification failure that opened the issue
+	Detail string `protobuf:"bytes,6,opt,name=detail,proto3" json:"detail,omitempty"`
 }
 
-func (x *GetRippedResponse) Reset() {
-	*x = GetRippedResponse{}
+func (x *Outstanding) Reset() {
+	*x = Outstanding{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_cdprocessor_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -343,13 +699,13 @@ func (x *GetRippedResponse) Reset() {
 	}
 }
 
-func (x *GetRippedResponse) String() string {
+func (x *Outstanding) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetRippedResponse) ProtoMessage() {}
+func (*Outstanding) ProtoMessage() {}
 
-func (x *GetRippedResponse) ProtoReflect() protoreflect.Message {
+func (x *Outstanding) ProtoReflect() protoreflect.Message {
 	mi := &file_cdprocessor_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -361,41 +717,84 @@ func (x *GetRippedResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetRippedResponse.ProtoReflect.Descriptor instead.
-func (*GetRippedResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use Outstanding.ProtoReflect.Descriptor instead.
+func (*Outstanding) Descriptor() ([]byte, []int) {
 	return file_cdprocessor_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *GetRippedResponse) GetRipped() []*Rip {
+func (x *Outstanding) GetReleaseId() int32 {
 	if x != nil {
-		return x.Ripped
+		return x.ReleaseId
 	}
-	return nil
+	return 0
 }
 
-type GetMissingRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *Outstanding) GetInstanceId() int32 {
+	if x != nil {
+		return x.InstanceId
+	}
+	return 0
 }
 
-func (x *GetMissingRequest) Reset() {
-	*x = GetMissingRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_cdprocessor_proto_msgTypes[5]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *Outstanding) GetTitle() string {
+	if x != nil {
+		return x.Title
 	}
+	return ""
 }
 
-func (x *GetMissingRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Outstanding) GetIssue() int32 {
+	if x != nil {
+		return x.Issue
+	}
+	return 0
 }
 
-func (*GetMissingRequest) ProtoMessage() {}
-
-func (x *GetMissingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_cdprocessor_proto_msgTypes[5]
+func (x *Outstanding) GetOpened() int64 {
+	if x != nil {
+		return x.Opened
+	}
+	return 0
+}
+
+func (x *Outstanding) GetDetail() string {
+	if x != nil {
+		return x.Detail
+	}
+	return ""
+}
+
+type QueueEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	InstanceId  int32             `protobuf:"varint,1,opt,name=instance_id,json=instanceId,proto3" json:"instance_id,omitempty"`
+	Reason      QueueEntry_Reason `protobuf:"varint,2,opt,name=reason,proto3,enum=cdprocessor.QueueEntry_Reason" json:"reason,omitempty"`
+	Detail      string            `protobuf:"bytes,3,opt,name=detail,proto3" json:"detail,omitempty"`
+	EnqueueTime int64             `protobuf:"varint,4,opt,name=enqueue_time,json=enqueueTime,proto3" json:"enqueue_time,omitempty"`
+	// The entry is skipped by GetMissing until this time
+	SnoozeUntil  int64  `protobuf:"varint,5,opt,name=snooze_until,json=snoozeUntil,proto3" json:"snooze_until,omitempty"`
+	SnoozeReason string `protobuf:"bytes,6,opt,name=snooze_reason,json=snoozeReason,proto3" json:"snooze_reason,omitempty"`
+}
+
+func (x *QueueEntry) Reset() {
+	*x = QueueEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cdprocessor_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueueEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueueEntry) ProtoMessage() {}
+
+func (x *QueueEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_cdprocessor_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -406,21 +805,76 @@ func (x *GetMissingRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetMissingRequest.ProtoReflect.Descriptor instead.
-func (*GetMissingRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use QueueEntry.ProtoReflect.Descriptor instead.
+func (*QueueEntry) Descriptor() ([]byte, []int) {
 	return file_cdprocessor_proto_rawDescGZIP(), []int{5}
 }
 
-type GetMissingResponse struct {
+func (x *QueueEntry) GetInstanceId() int32 {
+	if x != nil {
+		return x.InstanceId
+	}
+	return 0
+}
+
+func (x *QueueEntry) GetReason() QueueEntry_Reason {
+	if x != nil {
+		return x.Reason
+	}
+	return QueueEntry_UNKNOWN
+}
+
+func (x *QueueEntry) GetDetail() string {
+	if x != nil {
+		return x.Detail
+	}
+	return ""
+}
+
+func (x *QueueEntry) GetEnqueueTime() int64 {
+	if x != nil {
+		return x.EnqueueTime
+	}
+	return 0
+}
+
+func (x *QueueEntry) GetSnoozeUntil() int64 {
+	if x != nil {
+		return x.SnoozeUntil
+	}
+	return 0
+}
+
+func (x *QueueEntry) GetSnoozeReason() string {
+	if x != nil {
+		return x.SnoozeReason
+	}
+	return ""
+}
+
+type GetRippedRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Missing []*proto.Record `protobuf:"bytes,1,rep,name=missing,proto3" json:"missing,omitempty"`
+	// Only return rips of this release, or of the release of this instance, 0 returns all ids
+	Id int32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Only return rips holding tracks from this disk, 0 returns all disks
+	Disk int32 `protobuf:"varint,2,opt,name=disk,proto3" json:"disk,omitempty"`
+	// Only return rips with at least one track missing an mp3
+	MissingMp3 bool `protobuf:"varint,3,opt,name=missing_mp3,json=missingMp3,proto3" json:"missing_mp3,omitempty"`
+	// Only return rips with at least one track missing a flac
+	MissingFlac bool `protobuf:"varint,4,opt,name=missing_flac,json=missingFlac,proto3" json:"missing_flac,omitempty"`
+	// Only return rips with at least one track that only has a wav
+	WavOnly bool `protobuf:"varint,5,opt,name=wav_only,json=wavOnly,proto3" json:"wav_only,omitempty"`
+	// The next_page_token from a previous response
+	PageToken string `protobuf:"bytes,6,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// The maximum number of rips to return, 0 returns everything
+	PageSize int32 `protobuf:"varint,7,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
 }
 
-func (x *GetMissingResponse) Reset() {
-	*x = GetMissingResponse{}
+func (x *GetRippedRequest) Reset() {
+	*x = GetRippedRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_cdprocessor_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -428,13 +882,13 @@ func (x *GetMissingResponse) Reset() {
 	}
 }
 
-func (x *GetMissingResponse) String() string {
+func (x *GetRippedRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetMissingResponse) ProtoMessage() {}
+func (*GetRippedRequest) ProtoMessage() {}
 
-func (x *GetMissingResponse) ProtoReflect() protoreflect.Message {
+func (x *GetRippedRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_cdprocessor_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -446,29 +900,86 @@ func (x *GetMissingResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetMissingResponse.ProtoReflect.Descriptor instead.
-func (*GetMissingResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetRippedRequest.ProtoReflect.Descriptor instead.
+func (*GetRippedRequest) Descriptor() ([]byte, []int) {
 	return file_cdprocessor_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *GetMissingResponse) GetMissing() []*proto.Record {
+func (x *GetRippedRequest) GetId() int32 {
 	if x != nil {
-		return x.Missing
+		return x.Id
 	}
-	return nil
+	return 0
 }
 
-type ForceRequest struct {
+func (x *GetRippedRequest) GetDisk() int32 {
+	if x != nil {
+		return x.Disk
+	}
+	return 0
+}
+
+func (x *GetRippedRequest) GetMissingMp3() bool {
+	if x != nil {
+		return x.MissingMp3
+	}
+	return false
+}
+
+func (x *GetRippedRequest) GetMissingFlac() bool {
+	if x != nil {
+		return x.MissingFlac
+	}
+	return false
+}
+
+func (x *GetRippedRequest) GetWavOnly() bool {
+	if x != nil {
+		return x.WavOnly
+	}
+	return false
+}
+
+func (x *GetRippedRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *GetRippedRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+// AudioInfo describes a single audio file, as read from its headers
+type AudioInfo struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Type ForceRequest_ForceType `protobuf:"varint,1,opt,name=type,proto3,enum=cdprocessor.ForceRequest_ForceType" json:"type,omitempty"`
-	Id   int32                  `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	Size       int64 `protobuf:"varint,1,opt,name=size,proto3" json:"size,omitempty"`
+	Mtime      int64 `protobuf:"varint,2,opt,name=mtime,proto3" json:"mtime,omitempty"`
+	DurationMs int64 `protobuf:"varint,3,opt,name=duration_ms,json=durationMs,proto3" json:"duration_ms,omitempty"`
+	SampleRate int32 `protobuf:"varint,4,opt,name=sample_rate,json=sampleRate,proto3" json:"sample_rate,omitempty"`
+	BitDepth   int32 `protobuf:"varint,5,opt,name=bit_depth,json=bitDepth,proto3" json:"bit_depth,omitempty"`
+	Channels   int32 `protobuf:"varint,6,opt,name=channels,proto3" json:"channels,omitempty"`
+	// In kbps, averaged over the file for variable bitrate mp3s
+	Bitrate int32 `protobuf:"varint,7,opt,name=bitrate,proto3" json:"bitrate,omitempty"`
+	// Set when the headers couldn't be read
+	Error string `protobuf:"bytes,8,opt,name=error,proto3" json:"error,omitempty"`
+	// Set when a full decode found the file damaged
+	Corrupt string `protobuf:"bytes,9,opt,name=corrupt,proto3" json:"corrupt,omitempty"`
+	// When the file was last fully decoded, 0 if it never has been
+	Checked int64 `protobuf:"varint,10,opt,name=checked,proto3" json:"checked,omitempty"`
+	// How many full decodes in a row have failed
+	Failures int32 `protobuf:"varint,11,opt,name=failures,proto3" json:"failures,omitempty"`
 }
 
-func (x *ForceRequest) Reset() {
-	*x = ForceRequest{}
+func (x *AudioInfo) Reset() {
+	*x = AudioInfo{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_cdprocessor_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -476,13 +987,13 @@ func (x *ForceRequest) Reset() {
 	}
 }
 
-func (x *ForceRequest) String() string {
+func (x *AudioInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ForceRequest) ProtoMessage() {}
+func (*AudioInfo) ProtoMessage() {}
 
-func (x *ForceRequest) ProtoReflect() protoreflect.Message {
+func (x *AudioInfo) ProtoReflect() protoreflect.Message {
 	mi := &file_cdprocessor_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -494,33 +1005,105 @@ func (x *ForceRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ForceRequest.ProtoReflect.Descriptor instead.
-func (*ForceRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use AudioInfo.ProtoReflect.Descriptor instead.
+func (*AudioInfo) Descriptor() ([]byte, []int) {
 	return file_cdprocessor_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *ForceRequest) GetType() ForceRequest_ForceType {
+func (x *AudioInfo) GetSize() int64 {
 	if x != nil {
-		return x.Type
+		return x.Size
 	}
-	return ForceRequest_UNKNOWN
+	return 0
 }
 
-func (x *ForceRequest) GetId() int32 {
+func (x *AudioInfo) GetMtime() int64 {
 	if x != nil {
-		return x.Id
+		return x.Mtime
 	}
 	return 0
 }
 
-type ForceResponse struct {
+func (x *AudioInfo) GetDurationMs() int64 {
+	if x != nil {
+		return x.DurationMs
+	}
+	return 0
+}
+
+func (x *AudioInfo) GetSampleRate() int32 {
+	if x != nil {
+		return x.SampleRate
+	}
+	return 0
+}
+
+func (x *AudioInfo) GetBitDepth() int32 {
+	if x != nil {
+		return x.BitDepth
+	}
+	return 0
+}
+
+func (x *AudioInfo) GetChannels() int32 {
+	if x != nil {
+		return x.Channels
+	}
+	return 0
+}
+
+func (x *AudioInfo) GetBitrate() int32 {
+	if x != nil {
+		return x.Bitrate
+	}
+	return 0
+}
+
+func (x *AudioInfo) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *AudioInfo) GetCorrupt() string {
+	if x != nil {
+		return x.Corrupt
+	}
+	return ""
+}
+
+func (x *AudioInfo) GetChecked() int64 {
+	if x != nil {
+		return x.Checked
+	}
+	return 0
+}
+
+func (x *AudioInfo) GetFailures() int32 {
+	if x != nil {
+		return x.Failures
+	}
+	return 0
+}
+
+type Track struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Disk        int32      `protobuf:"varint,5,opt,name=disk,proto3" json:"disk,omitempty"`
+	TrackNumber int32      `protobuf:"varint,1,opt,name=track_number,json=trackNumber,proto3" json:"track_number,omitempty"`
+	WavPath     string     `protobuf:"bytes,2,opt,name=wav_path,json=wavPath,proto3" json:"wav_path,omitempty"`
+	Mp3Path     string     `protobuf:"bytes,3,opt,name=mp3_path,json=mp3Path,proto3" json:"mp3_path,omitempty"`
+	FlacPath    string     `protobuf:"bytes,4,opt,name=flac_path,json=flacPath,proto3" json:"flac_path,omitempty"`
+	Wav         *AudioInfo `protobuf:"bytes,6,opt,name=wav,proto3" json:"wav,omitempty"`
+	Mp3         *AudioInfo `protobuf:"bytes,7,opt,name=mp3,proto3" json:"mp3,omitempty"`
+	Flac        *AudioInfo `protobuf:"bytes,8,opt,name=flac,proto3" json:"flac,omitempty"`
 }
 
-func (x *ForceResponse) Reset() {
-	*x = ForceResponse{}
+func (x *Track) Reset() {
+	*x = Track{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_cdprocessor_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -528,13 +1111,13 @@ func (x *ForceResponse) Reset() {
 	}
 }
 
-func (x *ForceResponse) String() string {
+func (x *Track) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ForceResponse) ProtoMessage() {}
+func (*Track) ProtoMessage() {}
 
-func (x *ForceResponse) ProtoReflect() protoreflect.Message {
+func (x *Track) ProtoReflect() protoreflect.Message {
 	mi := &file_cdprocessor_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -546,19 +1129,88 @@ func (x *ForceResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ForceResponse.ProtoReflect.Descriptor instead.
-func (*ForceResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use Track.ProtoReflect.Descriptor instead.
+func (*Track) Descriptor() ([]byte, []int) {
 	return file_cdprocessor_proto_rawDescGZIP(), []int{8}
 }
 
-type GetOutstandingRequest struct {
+func (x *Track) GetDisk() int32 {
+	if x != nil {
+		return x.Disk
+	}
+	return 0
+}
+
+func (x *Track) GetTrackNumber() int32 {
+	if x != nil {
+		return x.TrackNumber
+	}
+	return 0
+}
+
+func (x *Track) GetWavPath() string {
+	if x != nil {
+		return x.WavPath
+	}
+	return ""
+}
+
+func (x *Track) GetMp3Path() string {
+	if x != nil {
+		return x.Mp3Path
+	}
+	return ""
+}
+
+func (x *Track) GetFlacPath() string {
+	if x != nil {
+		return x.FlacPath
+	}
+	return ""
+}
+
+func (x *Track) GetWav() *AudioInfo {
+	if x != nil {
+		return x.Wav
+	}
+	return nil
+}
+
+func (x *Track) GetMp3() *AudioInfo {
+	if x != nil {
+		return x.Mp3
+	}
+	return nil
+}
+
+func (x *Track) GetFlac() *AudioInfo {
+	if x != nil {
+		return x.Flac
+	}
+	return nil
+}
+
+type Rip struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Id     int32    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Path   string   `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	Tracks []*Track `protobuf:"bytes,3,rep,name=tracks,proto3" json:"tracks,omitempty"`
+	// The modification time (in nanoseconds) of the rip directory when it was read
+	DirMtime int64 `protobuf:"varint,4,opt,name=dir_mtime,json=dirMtime,proto3" json:"dir_mtime,omitempty"`
+	// Files in the rip directory which don't match any track naming scheme
+	Unrecognised []string `protobuf:"bytes,5,rep,name=unrecognised,proto3" json:"unrecognised,omitempty"`
+	// Set when the last read of the directory failed, the tracks are from the read before
+	ScanError string `protobuf:"bytes,6,opt,name=scan_error,json=scanError,proto3" json:"scan_error,omitempty"`
+	// Any human readable suffix on the directory name
+	Slug string `protobuf:"bytes,7,opt,name=slug,proto3" json:"slug,omitempty"`
+	Disk int32  `protobuf:"varint,8,opt,name=disk,proto3" json:"disk,omitempty"`
 }
 
-func (x *GetOutstandingRequest) Reset() {
-	*x = GetOutstandingRequest{}
+func (x *Rip) Reset() {
+	*x = Rip{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_cdprocessor_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -566,13 +1218,13 @@ func (x *GetOutstandingRequest) Reset() {
 	}
 }
 
-func (x *GetOutstandingRequest) String() string {
+func (x *Rip) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetOutstandingRequest) ProtoMessage() {}
+func (*Rip) ProtoMessage() {}
 
-func (x *GetOutstandingRequest) ProtoReflect() protoreflect.Message {
+func (x *Rip) ProtoReflect() protoreflect.Message {
 	mi := &file_cdprocessor_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -584,21 +1236,78 @@ func (x *GetOutstandingRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetOutstandingRequest.ProtoReflect.Descriptor instead.
-func (*GetOutstandingRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use Rip.ProtoReflect.Descriptor instead.
+func (*Rip) Descriptor() ([]byte, []int) {
 	return file_cdprocessor_proto_rawDescGZIP(), []int{9}
 }
 
-type GetOutstandingResponse struct {
+func (x *Rip) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Rip) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *Rip) GetTracks() []*Track {
+	if x != nil {
+		return x.Tracks
+	}
+	return nil
+}
+
+func (x *Rip) GetDirMtime() int64 {
+	if x != nil {
+		return x.DirMtime
+	}
+	return 0
+}
+
+func (x *Rip) GetUnrecognised() []string {
+	if x != nil {
+		return x.Unrecognised
+	}
+	return nil
+}
+
+func (x *Rip) GetScanError() string {
+	if x != nil {
+		return x.ScanError
+	}
+	return ""
+}
+
+func (x *Rip) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+func (x *Rip) GetDisk() int32 {
+	if x != nil {
+		return x.Disk
+	}
+	return 0
+}
+
+type RipIndex struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Ids []int32 `protobuf:"varint,1,rep,packed,name=ids,proto3" json:"ids,omitempty"`
+	Rips      []*Rip `protobuf:"bytes,1,rep,name=rips,proto3" json:"rips,omitempty"`
+	BuildTime int64  `protobuf:"varint,2,opt,name=build_time,json=buildTime,proto3" json:"build_time,omitempty"`
 }
 
-func (x *GetOutstandingResponse) Reset() {
-	*x = GetOutstandingResponse{}
+func (x *RipIndex) Reset() {
+	*x = RipIndex{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_cdprocessor_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -606,214 +1315,4373 @@ func (x *GetOutstandingResponse) Reset() {
 	}
 }
 
-func (x *GetOutstandingResponse) String() string {
+func (x *RipIndex) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetOutstandingResponse) ProtoMessage() {}
+func (*RipIndex) ProtoMessage() {}
 
-func (x *GetOutstandingResponse) ProtoReflect() protoreflect.Message {
+func (x *RipIndex) ProtoReflect() protoreflect.Message {
 	mi := &file_cdprocessor_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
 		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use GetOutstandingResponse.ProtoReflect.Descriptor instead.
-func (*GetOutstandingResponse) Descriptor() ([]byte, []int) {
-	return file_cdprocessor_proto_rawDescGZIP(), []int{10}
-}
-
-func (x *GetOutstandingResponse) GetIds() []int32 {
-	if x != nil {
-		return x.Ids
-	}
-	return nil
-}
-
-var File_cdprocessor_proto protoreflect.FileDescriptor
-
-var file_cdprocessor_proto_rawDesc = []byte{
-	0x0a, 0x11, 0x63, 0x64, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x12, 0x0b, 0x63, 0x64, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72,
-	0x1a, 0x45, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x72, 0x6f,
-	0x74, 0x68, 0x65, 0x72, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2f, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64,
-	0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x2f, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xd3, 0x04, 0x0a, 0x06, 0x43, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x12, 0x54, 0x0a, 0x11, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x70, 0x72, 0x6f, 0x63, 0x65,
-	0x73, 0x73, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e,
-	0x63, 0x64, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x2e, 0x43, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x2e, 0x4c, 0x61, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x54, 0x69,
-	0x6d, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0f, 0x6c, 0x61, 0x73, 0x74, 0x50, 0x72, 0x6f,
-	0x63, 0x65, 0x73, 0x73, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x4a, 0x0a, 0x0d, 0x69, 0x73, 0x73, 0x75,
-	0x65, 0x5f, 0x6d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x25, 0x2e, 0x63, 0x64, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x2e, 0x43, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x2e, 0x49, 0x73, 0x73, 0x75, 0x65, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e,
-	0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0c, 0x69, 0x73, 0x73, 0x75, 0x65, 0x4d, 0x61, 0x70,
-	0x70, 0x69, 0x6e, 0x67, 0x12, 0x48, 0x0a, 0x0d, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x72, 0x69, 0x70,
-	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x63, 0x64,
-	0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x2e, 0x4c, 0x61, 0x73, 0x74, 0x52, 0x69, 0x70, 0x54, 0x69, 0x6d, 0x65, 0x45, 0x6e, 0x74, 0x72,
-	0x79, 0x52, 0x0b, 0x6c, 0x61, 0x73, 0x74, 0x52, 0x69, 0x70, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x44,
-	0x0a, 0x0b, 0x67, 0x6f, 0x61, 0x6c, 0x5f, 0x66, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x18, 0x04, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x63, 0x64, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x6f,
-	0x72, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x47, 0x6f, 0x61, 0x6c, 0x46, 0x6f, 0x6c,
-	0x64, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x67, 0x6f, 0x61, 0x6c, 0x46, 0x6f,
-	0x6c, 0x64, 0x65, 0x72, 0x12, 0x13, 0x0a, 0x05, 0x74, 0x6f, 0x5f, 0x67, 0x6f, 0x18, 0x05, 0x20,
-	0x03, 0x28, 0x05, 0x52, 0x04, 0x74, 0x6f, 0x47, 0x6f, 0x1a, 0x42, 0x0a, 0x14, 0x4c, 0x61, 0x73,
-	0x74, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x54, 0x69, 0x6d, 0x65, 0x45, 0x6e, 0x74, 0x72,
-	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03,
-	0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x3f, 0x0a,
-	0x11, 0x49, 0x73, 0x73, 0x75, 0x65, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x45, 0x6e, 0x74,
-	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x3e,
-	0x0a, 0x10, 0x4c, 0x61, 0x73, 0x74, 0x52, 0x69, 0x70, 0x54, 0x69, 0x6d, 0x65, 0x45, 0x6e, 0x74,
-	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x3d,
-	0x0a, 0x0f, 0x47, 0x6f, 0x61, 0x6c, 0x46, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x72,
-	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03,
-	0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x12, 0x0a,
-	0x10, 0x47, 0x65, 0x74, 0x52, 0x69, 0x70, 0x70, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x22, 0x91, 0x01, 0x0a, 0x05, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x12, 0x12, 0x0a, 0x04, 0x64,
-	0x69, 0x73, 0x6b, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x64, 0x69, 0x73, 0x6b, 0x12,
-	0x21, 0x0a, 0x0c, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62,
-	0x65, 0x72, 0x12, 0x19, 0x0a, 0x08, 0x77, 0x61, 0x76, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x77, 0x61, 0x76, 0x50, 0x61, 0x74, 0x68, 0x12, 0x19, 0x0a,
-	0x08, 0x6d, 0x70, 0x33, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x07, 0x6d, 0x70, 0x33, 0x50, 0x61, 0x74, 0x68, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x6c, 0x61, 0x63,
-	0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x6c, 0x61,
-	0x63, 0x50, 0x61, 0x74, 0x68, 0x22, 0x55, 0x0a, 0x03, 0x52, 0x69, 0x70, 0x12, 0x0e, 0x0a, 0x02,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04,
-	0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68,
-	0x12, 0x2a, 0x0a, 0x06, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x12, 0x2e, 0x63, 0x64, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x2e, 0x54,
-	0x72, 0x61, 0x63, 0x6b, 0x52, 0x06, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x22, 0x3d, 0x0a, 0x11,
-	0x47, 0x65, 0x74, 0x52, 0x69, 0x70, 0x70, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x28, 0x0a, 0x06, 0x72, 0x69, 0x70, 0x70, 0x65, 0x64, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x10, 0x2e, 0x63, 0x64, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x2e,
-	0x52, 0x69, 0x70, 0x52, 0x06, 0x72, 0x69, 0x70, 0x70, 0x65, 0x64, 0x22, 0x13, 0x0a, 0x11, 0x47,
-	0x65, 0x74, 0x4d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x22, 0x48, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x4d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x07, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6e,
-	0x67, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64,
-	0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x72,
-	0x64, 0x52, 0x07, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x22, 0x85, 0x01, 0x0a, 0x0c, 0x46,
-	0x6f, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x37, 0x0a, 0x04, 0x74,
-	0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x23, 0x2e, 0x63, 0x64, 0x70, 0x72,
-	0x6f, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x2e, 0x46, 0x6f, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x2e, 0x46, 0x6f, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04,
-	0x74, 0x79, 0x70, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x02, 0x69, 0x64, 0x22, 0x2c, 0x0a, 0x09, 0x46, 0x6f, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70,
-	0x65, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x12,
-	0x0a, 0x0e, 0x52, 0x45, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x5f, 0x4c, 0x49, 0x4e, 0x4b, 0x53,
-	0x10, 0x01, 0x22, 0x0f, 0x0a, 0x0d, 0x46, 0x6f, 0x72, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x17, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x4f, 0x75, 0x74, 0x73, 0x74, 0x61,
-	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x2a, 0x0a, 0x16,
-	0x47, 0x65, 0x74, 0x4f, 0x75, 0x74, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x05, 0x52, 0x03, 0x69, 0x64, 0x73, 0x32, 0xc3, 0x02, 0x0a, 0x0b, 0x43, 0x44, 0x50,
-	0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x12, 0x4a, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x52,
-	0x69, 0x70, 0x70, 0x65, 0x64, 0x12, 0x1d, 0x2e, 0x63, 0x64, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73,
-	0x73, 0x6f, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x69, 0x70, 0x70, 0x65, 0x64, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x63, 0x64, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73,
-	0x6f, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x69, 0x70, 0x70, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4d, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x4d, 0x69, 0x73, 0x73, 0x69,
-	0x6e, 0x67, 0x12, 0x1e, 0x2e, 0x63, 0x64, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72,
-	0x2e, 0x47, 0x65, 0x74, 0x4d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x63, 0x64, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72,
-	0x2e, 0x47, 0x65, 0x74, 0x4d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x05, 0x46, 0x6f, 0x72, 0x63, 0x65, 0x12, 0x19, 0x2e, 0x63,
-	0x64, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x2e, 0x46, 0x6f, 0x72, 0x63, 0x65,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x63, 0x64, 0x70, 0x72, 0x6f, 0x63,
-	0x65, 0x73, 0x73, 0x6f, 0x72, 0x2e, 0x46, 0x6f, 0x72, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x59, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x4f, 0x75, 0x74, 0x73, 0x74, 0x61,
-	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x22, 0x2e, 0x63, 0x64, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73,
-	0x73, 0x6f, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x4f, 0x75, 0x74, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x69,
-	0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x63, 0x64, 0x70, 0x72,
-	0x6f, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x4f, 0x75, 0x74, 0x73, 0x74,
-	0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x2b,
-	0x5a, 0x29, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x72, 0x6f,
-	0x74, 0x68, 0x65, 0x72, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2f, 0x63, 0x64, 0x70, 0x72, 0x6f, 0x63,
-	0x65, 0x73, 0x73, 0x6f, 0x72, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x33,
-}
-
-var (
-	file_cdprocessor_proto_rawDescOnce sync.Once
-	file_cdprocessor_proto_rawDescData = file_cdprocessor_proto_rawDesc
-)
-
-func file_cdprocessor_proto_rawDescGZIP() []byte {
-	file_cdprocessor_proto_rawDescOnce.Do(func() {
-		file_cdprocessor_proto_rawDescData = protoimpl.X.CompressGZIP(file_cdprocessor_proto_rawDescData)
-	})
-	return file_cdprocessor_proto_rawDescData
-}
-
-var file_cdprocessor_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_cdprocessor_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
-var file_cdprocessor_proto_goTypes = []interface{}{
-	(ForceRequest_ForceType)(0),    // 0: cdprocessor.ForceRequest.ForceType
-	(*Config)(nil),                 // 1: cdprocessor.Config
-	(*GetRippedRequest)(nil),       // 2: cdprocessor.GetRippedRequest
-	(*Track)(nil),                  // 3: cdprocessor.Track
-	(*Rip)(nil),                    // 4: cdprocessor.Rip
-	(*GetRippedResponse)(nil),      // 5: cdprocessor.GetRippedResponse
-	(*GetMissingRequest)(nil),      // 6: cdprocessor.GetMissingRequest
-	(*GetMissingResponse)(nil),     // 7: cdprocessor.GetMissingResponse
-	(*ForceRequest)(nil),           // 8: cdprocessor.ForceRequest
-	(*ForceResponse)(nil),          // 9: cdprocessor.ForceResponse
-	(*GetOutstandingRequest)(nil),  // 10: cdprocessor.GetOutstandingRequest
-	(*GetOutstandingResponse)(nil), // 11: cdprocessor.GetOutstandingResponse
-	nil,                            // 12: cdprocessor.Config.LastProcessTimeEntry
-	nil,                            // 13: cdprocessor.Config.IssueMappingEntry
-	nil,                            // 14: cdprocessor.Config.LastRipTimeEntry
-	nil,                            // 15: cdprocessor.Config.GoalFolderEntry
-	(*proto.Record)(nil),           // 16: recordcollection.Record
-}
-var file_cdprocessor_proto_depIdxs = []int32{
-	12, // 0: cdprocessor.Config.last_process_time:type_name -> cdprocessor.Config.LastProcessTimeEntry
-	13, // 1: cdprocessor.Config.issue_mapping:type_name -> cdprocessor.Config.IssueMappingEntry
-	14, // 2: cdprocessor.Config.last_rip_time:type_name -> cdprocessor.Config.LastRipTimeEntry
-	15, // 3: cdprocessor.Config.goal_folder:type_name -> cdprocessor.Config.GoalFolderEntry
-	3,  // 4: cdprocessor.Rip.tracks:type_name -> cdprocessor.Track
-	4,  // 5: cdprocessor.GetRippedResponse.ripped:type_name -> cdprocessor.Rip
-	16, // 6: cdprocessor.GetMissingResponse.missing:type_name -> recordcollection.Record
-	0,  // 7: cdprocessor.ForceRequest.type:type_name -> cdprocessor.ForceRequest.ForceType
-	2,  // 8: cdprocessor.CDProcessor.GetRipped:input_type -> cdprocessor.GetRippedRequest
-	6,  // 9: cdprocessor.CDProcessor.GetMissing:input_type -> cdprocessor.GetMissingRequest
-	8,  // 10: cdprocessor.CDProcessor.Force:input_type -> cdprocessor.ForceRequest
-	10, // 11: cdprocessor.CDProcessor.GetOutstanding:input_type -> cdprocessor.GetOutstandingRequest
-	5,  // 12: cdprocessor.CDProcessor.GetRipped:output_type -> cdprocessor.GetRippedResponse
-	7,  // 13: cdprocessor.CDProcessor.GetMissing:output_type -> cdprocessor.GetMissingResponse
-	9,  // 14: cdprocessor.CDProcessor.Force:output_type -> cdprocessor.ForceResponse
-	11, // 15: cdprocessor.CDProcessor.GetOutstanding:output_type -> cdprocessor.GetOutstandingResponse
-	12, // [12:16] is the sub-list for method output_type
-	8,  // [8:12] is the sub-list for method input_type
-	8,  // [8:8] is the sub-list for extension type_name
-	8,  // [8:8] is the sub-list for extension extendee
-	0,  // [0:8] is the sub-list for field type_name
-}
-
-func init() { file_cdprocessor_proto_init() }
-func file_cdprocessor_proto_init() {
-	if File_cdprocessor_proto != nil {
-		return
-	}
-	if !protoimpl.UnsafeEnabled {
-		file_cdprocessor_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Config); i {
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RipIndex.ProtoReflect.Descriptor instead.
+func (*RipIndex) Descriptor() ([]byte, []int) {
+	return file_cdprocessor_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *RipIndex) GetRips() []*Rip {
+	if x != nil {
+		return x.Rips
+	}
+	return nil
+}
+
+func (x *RipIndex) GetBuildTime() int64 {
+	if x != nil {
+		return x.BuildTime
+	}
+	return 0
+}
+
+type GetRippedResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ripped []*Rip `protobuf:"bytes,1,rep,name=ripped,proto3" json:"ripped,omitempty"`
+	// Set when there are further rips to read
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+}
+
+func (x *GetRippedResponse) Reset() {
+	*x = GetRippedResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cdprocessor_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetRippedResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRippedResponse) ProtoMessage() {}
+
+func (x *GetRippedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cdprocessor_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRippedResponse.ProtoReflect.Descriptor instead.
+func (*GetRippedResponse) Descriptor() ([]byte, []int) {
+	return file_cdprocessor_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetRippedResponse) GetRipped() []*Rip {
+	if x != nil {
+		return x.Ripped
+	}
+	return nil
+}
+
+func (x *GetRippedResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type GetMissingRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The number of queue entries to return, 0 returns the whole queue
+	Max            int32 `protobuf:"varint,1,opt,name=max,proto3" json:"max,omitempty"`
+	IncludeSnoozed bool  `protobuf:"varint,2,opt,name=include_snoozed,json=includeSnoozed,proto3" json:"include_snoozed,omitempty"`
+}
+
+func (x *GetMissingRequest) Reset() {
+	*x = GetMissingRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cdprocessor_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetMissingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMissingRequest) ProtoMessage() {}
+
+func (x *GetMissingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cdprocessor_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMissingRequest.ProtoReflect.Descriptor instead.
+func (*GetMissingRequest) Descriptor() ([]byte, []int) {
+	return file_cdprocessor_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetMissingRequest) GetMax() int32 {
+	if x != nil {
+		return x.Max
+	}
+	return 0
+}
+
+func (x *GetMissingRequest) GetIncludeSnoozed() bool {
+	if x != nil {
+		return x.IncludeSnoozed
+	}
+	return false
+}
+
+type MissingRecord struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Record *proto.Record `protobuf:"bytes,1,opt,name=record,proto3" json:"record,omitempty"`
+	Entry  *QueueEntry   `protobuf:"bytes,2,opt,name=entry,proto3" json:"entry,omitempty"`
+	// Set, with no record, when the record could not be looked up
+	Error string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *MissingRecord) Reset() {
+	*x = MissingRecord{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cdprocessor_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MissingRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MissingRecord) ProtoMessage() {}
+
+func (x *MissingRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_cdprocessor_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MissingRecord.ProtoReflect.Descriptor instead.
+func (*MissingRecord) Descriptor() ([]byte, []int) {
+	return file_cdprocessor_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *MissingRecord) GetRecord() *proto.Record {
+	if x != nil {
+		return x.Record
+	}
+	return nil
+}
+
+func (x *MissingRecord) GetEntry() *QueueEntry {
+	if x != nil {
+		return x.Entry
+	}
+	return nil
+}
+
+func (x *MissingRecord) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type GetMissingResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The records of queue that could be looked up, kept for clients that
+	// predate the queue
+	Missing []*proto.Record `protobuf:"bytes,1,rep,name=missing,proto3" json:"missing,omitempty"`
+	// The queue in order, this is the source of truth
+	Queue []*MissingRecord `protobuf:"bytes,2,rep,name=queue,proto3" json:"queue,omitempty"`
+}
+
+func (x *GetMissingResponse) Reset() {
+	*x = GetMissingResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cdprocessor_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetMissingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMissingResponse) ProtoMessage() {}
+
+func (x *GetMissingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cdprocessor_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMissingResponse.ProtoReflect.Descriptor instead.
+func (*GetMissingResponse) Descriptor() ([]byte, []int) {
+	return file_cdprocessor_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GetMissingResponse) GetMissing() []*proto.Record {
+	if x != nil {
+		return x.Missing
+	}
+	return nil
+}
+
+func (x *GetMissingResponse) GetQueue() []*MissingRecord {
+	if x != nil {
+		return x.Queue
+	}
+	return nil
+}
+
+type ForceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type ForceRequest_ForceType `protobuf:"varint,1,opt,name=type,proto3,enum=cdprocessor.ForceRequest_ForceType" json:"type,omitempty"`
+	// The instance id to force, RESCAN_DISK rescans every rip when this is unset
+	Id int32 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *ForceRequest) Reset() {
+	*x = ForceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cdprocessor_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ForceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForceRequest) ProtoMessage() {}
+
+func (x *ForceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cdprocessor_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForceRequest.ProtoReflect.Descriptor instead.
+func (*ForceRequest) Descriptor() ([]byte, []int) {
+	return file_cdprocessor_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ForceRequest) GetType() ForceRequest_ForceType {
+	if x != nil {
+		return x.Type
+	}
+	return ForceRequest_UNKNOWN
+}
+
+func (x *ForceRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type ForceResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ForceResponse) Reset() {
+	*x = ForceResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cdprocessor_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ForceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForceResponse) ProtoMessage() {}
+
+func (x *ForceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cdprocessor_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForceResponse.ProtoReflect.Descriptor instead.
+func (*ForceResponse) Descriptor() ([]byte, []int) {
+	return file_cdprocessor_proto_rawDescGZIP(), []int{16}
+}
+
+type GetOutstandingRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetOutstandingRequest) Reset() {
+	*x = GetOutstandingRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cdprocessor_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetOutstandingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOutstandingRequest) ProtoMessage() {}
+
+func (x *GetOutstandingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cdprocessor_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOutstandingRequest.ProtoReflect.Descriptor instead.
+func (*GetOutstandingRequest) Descriptor() ([]byte, []int) {
+	return file_cdprocessor_proto_rawDescGZIP(), []int{17}
+}
+
+type GetOutstandingResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ids     []int32        `protobuf:"varint,1,rep,packed,name=ids,proto3" json:"ids,omitempty"`
+	Records []*Outstanding `protobuf:"bytes,2,rep,name=records,proto3" json:"records,omitempty"`
+}
+
+func (x *GetOutstandingResponse) Reset() {
+	*x = GetOutstandingResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cdprocessor_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetOutstandingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOutstandingResponse) ProtoMessage() {}
+
+func (x *GetOutstandingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cdprocessor_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOutstandingResponse.ProtoReflect.Descriptor instead.
+func (*GetOutstandingResponse) Descriptor() ([]byte, []int) {
+	return file_cdprocessor_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *GetOutstandingResponse) GetIds() []int32 {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+func (x *GetOutstandingResponse) GetRecords() []*Outstanding {
+	if x != nil {
+		return x.Records
+	}
+	return nil
+}
+
+type RipEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type RipEvent_EventType `protobuf:"varint,1,opt,name=type,proto3,enum=cdprocessor.RipEvent_EventType" json:"type,omitempty"`
+	// The rip (or release) id this event concerns
+	Id         int32  `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	InstanceId int32  `protobuf:"varint,3,opt,name=instance_id,json=instanceId,proto3" json:"instance_id,omitempty"`
+	Disk       int32  `protobuf:"varint,4,opt,name=disk,proto3" json:"disk,omitempty"`
+	Track      int32  `protobuf:"varint,5,opt,name=track,proto3" json:"track,omitempty"`
+	Path       string `protobuf:"bytes,6,opt,name=path,proto3" json:"path,omitempty"`
+	Issue      int32  `protobuf:"varint,7,opt,name=issue,proto3" json:"issue,omitempty"`
+	Timestamp  int64  `protobuf:"varint,8,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+}
+
+func (x *RipEvent) Reset() {
+	*x = RipEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cdprocessor_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RipEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RipEvent) ProtoMessage() {}
+
+func (x *RipEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_cdprocessor_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RipEvent.ProtoReflect.Descriptor instead.
+func (*RipEvent) Descriptor() ([]byte, []int) {
+	return file_cdprocessor_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *RipEvent) GetType() RipEvent_EventType {
+	if x != nil {
+		return x.Type
+	}
+	return RipEvent_UNKNOWN
+}
+
+func (x *RipEvent) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *RipEvent) GetInstanceId() int32 {
+	if x != nil {
+		return x.InstanceId
+	}
+	return 0
+}
+
+func (x *RipEvent) GetDisk() int32 {
+	if x != nil {
+		return x.Disk
+	}
+	return 0
+}
+
+func (x *RipEvent) GetTrack() int32 {
+	if x != nil {
+		return x.Track
+	}
+	return 0
+}
+
+func (x *RipEvent) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *RipEvent) GetIssue() int32 {
+	if x != nil {
+		return x.Issue
+	}
+	return 0
+}
+
+func (x *RipEvent) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+type WatchRipsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Only send events for this id, 0 sends everything
+	Id int32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Only send events of these types, empty sends everything
+	Types []RipEvent_EventType `protobuf:"varint,2,rep,packed,name=types,proto3,enum=cdprocessor.RipEvent_EventType" json:"types,omitempty"`
+}
+
+func (x *WatchRipsRequest) Reset() {
+	*x = WatchRipsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cdprocessor_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchRipsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchRipsRequest) ProtoMessage() {}
+
+func (x *WatchRipsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cdprocessor_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchRipsRequest.ProtoReflect.Descriptor instead.
+func (*WatchRipsRequest) Descriptor() ([]byte, []int) {
+	return file_cdprocessor_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *WatchRipsRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *WatchRipsRequest) GetTypes() []RipEvent_EventType {
+	if x != nil {
+		return x.Types
+	}
+	return nil
+}
+
+type GetRipStatusRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	InstanceId int32 `protobuf:"varint,1,opt,name=instance_id,json=instanceId,proto3" json:"instance_id,omitempty"`
+}
+
+func (x *GetRipStatusRequest) Reset() {
+	*x = GetRipStatusRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cdprocessor_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetRipStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRipStatusRequest) ProtoMessage() {}
+
+func (x *GetRipStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cdprocessor_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRipStatusRequest.ProtoReflect.Descriptor instead.
+func (*GetRipStatusRequest) Descriptor() ([]byte, []int) {
+	return file_cdprocessor_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GetRipStatusRequest) GetInstanceId() int32 {
+	if x != nil {
+		return x.InstanceId
+	}
+	return 0
+}
+
+type ExpectedTrack struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Position string `protobuf:"bytes,1,opt,name=position,proto3" json:"position,omitempty"`
+	Title    string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Format   string `protobuf:"bytes,3,opt,name=format,proto3" json:"format,omitempty"`
+}
+
+func (x *ExpectedTrack) Reset() {
+	*x = ExpectedTrack{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cdprocessor_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExpectedTrack) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExpectedTrack) ProtoMessage() {}
+
+func (x *ExpectedTrack) ProtoReflect() protoreflect.Message {
+	mi := &file_cdprocessor_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExpectedTrack.ProtoReflect.Descriptor instead.
+func (*ExpectedTrack) Descriptor() ([]byte, []int) {
+	return file_cdprocessor_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ExpectedTrack) GetPosition() string {
+	if x != nil {
+		return x.Position
+	}
+	return ""
+}
+
+func (x *ExpectedTrack) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *ExpectedTrack) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+type ExpectedDisk struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Disk   string           `protobuf:"bytes,1,opt,name=disk,proto3" json:"disk,omitempty"`
+	Tracks []*ExpectedTrack `protobuf:"bytes,2,rep,name=tracks,proto3" json:"tracks,omitempty"`
+}
+
+func (x *ExpectedDisk) Reset() {
+	*x = ExpectedDisk{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cdprocessor_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExpectedDisk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExpectedDisk) ProtoMessage() {}
+
+func (x *ExpectedDisk) ProtoReflect() protoreflect.Message {
+	mi := &file_cdprocessor_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExpectedDisk.ProtoReflect.Descriptor instead.
+func (*ExpectedDisk) Descriptor() ([]byte, []int) {
+	return file_cdprocessor_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ExpectedDisk) GetDisk() string {
+	if x != nil {
+		return x.Disk
+	}
+	return ""
+}
+
+func (x *ExpectedDisk) GetTracks() []*ExpectedTrack {
+	if x != nil {
+		return x.Tracks
+	}
+	return nil
+}
+
+type GetRipStatusResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GoalFolder int32    `protobuf:"varint,1,opt,name=goal_folder,json=goalFolder,proto3" json:"goal_folder,omitempty"`
+	FolderId   int32    `protobuf:"varint,2,opt,name=folder_id,json=folderId,proto3" json:"folder_id,omitempty"`
+	Formats    []string `protobuf:"bytes,3,rep,name=formats,proto3" json:"formats,omitempty"`
+	// The tracks we expect to see, from TrackExtract
+	Disks []*ExpectedDisk `protobuf:"bytes,4,rep,name=disks,proto3" json:"disks,omitempty"`
+	// The rips we hold for this record and the files found on disk
+	Rips            []*Rip      `protobuf:"bytes,5,rep,name=rips,proto3" json:"rips,omitempty"`
+	RipFiles        []string    `protobuf:"bytes,6,rep,name=rip_files,json=ripFiles,proto3" json:"rip_files,omitempty"`
+	Mp3Files        []string    `protobuf:"bytes,7,rep,name=mp3_files,json=mp3Files,proto3" json:"mp3_files,omitempty"`
+	FlacFiles       []string    `protobuf:"bytes,8,rep,name=flac_files,json=flacFiles,proto3" json:"flac_files,omitempty"`
+	Issue           int32       `protobuf:"varint,9,opt,name=issue,proto3" json:"issue,omitempty"`
+	LastProcessTime int64       `protobuf:"varint,10,opt,name=last_process_time,json=lastProcessTime,proto3" json:"last_process_time,omitempty"`
+	LastRipTime     int64       `protobuf:"varint,11,opt,name=last_rip_time,json=lastRipTime,proto3" json:"last_rip_time,omitempty"`
+	InQueue         bool        `protobuf:"varint,12,opt,name=in_queue,json=inQueue,proto3" json:"in_queue,omitempty"`
+	QueueEntry      *QueueEntry `protobuf:"bytes,13,opt,name=queue_entry,json=queueEntry,proto3" json:"queue_entry,omitempty"`
+}
+
+func (x *GetRipStatusResponse) Reset() {
+	*x = GetRipStatusResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cdprocessor_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetRipStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRipStatusResponse) ProtoMessage() {}
+
+func (x *GetRipStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cdprocessor_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRipStatusResponse.ProtoReflect.Descriptor instead.
+func (*GetRipStatusResponse) Descriptor() ([]byte, []int) {
+	return file_cdprocessor_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *GetRipStatusResponse) GetGoalFolder() int32 {
+	if x != nil {
+		return x.GoalFolder
+	}
+	return 0
+}
+
+func (x *GetRipStatusResponse) GetFolderId() int32 {
+	if x != nil {
+		return x.FolderId
+	}
+	return 0
+}
+
+func (x *GetRipStatusResponse) GetFormats() []string {
+	if x != nil {
+		return x.Formats
+	}
+	return nil
+}
+
+func (x *GetRipStatusResponse) GetDisks() []*ExpectedDisk {
+	if x != nil {
+		return x.Disks
+	}
+	return nil
+}
+
+func (x *GetRipStatusResponse) GetRips() []*Rip {
+	if x != nil {
+		return x.Rips
+	}
+	return nil
+}
+
+func (x *GetRipStatusResponse) GetRipFiles() []string {
+	if x != nil {
+		return x.RipFiles
+	}
+	return nil
+}
+
+func (x *GetRipStatusResponse) GetMp3Files() []string {
+	if x != nil {
+		return x.Mp3Files
+	}
+	return nil
+}
+
+func (x *GetRipStatusResponse) GetFlacFiles() []string {
+	if x != nil {
+		return x.FlacFiles
+	}
+	return nil
+}
+
+func (x *GetRipStatusResponse) GetIssue() int32 {
+	if x != nil {
+		return x.Issue
+	}
+	return 0
+}
+
+func (x *GetRipStatusResponse) GetLastProcessTime() int64 {
+	if x != nil {
+		return x.LastProcessTime
+	}
+	return 0
+}
+
+func (x *GetRipStatusResponse) GetLastRipTime() int64 {
+	if x != nil {
+		return x.LastRipTime
+	}
+	return 0
+}
+
+func (x *GetRipStatusResponse) GetInQueue() bool {
+	if x != nil {
+		return x.InQueue
+	}
+	return false
+}
+
+func (x *GetRipStatusResponse) GetQueueEntry() *QueueEntry {
+	if x != nil {
+		return x.QueueEntry
+	}
+	return nil
+}
+
+type LinkRule struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name  string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Fired bool   `protobuf:"varint,2,opt,name=fired,proto3" json:"fired,omitempty"`
+	// The values the rule compared
+	Detail string `protobuf:"bytes,3,opt,name=detail,proto3" json:"detail,omitempty"`
+}
+
+func (x *LinkRule) Reset() {
+	*x = LinkRule{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cdprocessor_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LinkRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LinkRule) ProtoMessage() {}
+
+func (x *LinkRule) ProtoReflect() protoreflect.Message {
+	mi := &file_cdprocessor_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LinkRule.ProtoReflect.Descriptor instead.
+func (*LinkRule) Descriptor() ([]byte, []int) {
+	return file_cdprocessor_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *LinkRule) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *LinkRule) GetFired() bool {
+	if x != nil {
+		return x.Fired
+	}
+	return false
+}
+
+func (x *LinkRule) GetDetail() string {
+	if x != nil {
+		return x.Detail
+	}
+	return ""
+}
+
+type ExplainLinksRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	InstanceId int32 `protobuf:"varint,1,opt,name=instance_id,json=instanceId,proto3" json:"instance_id,omitempty"`
+	Force      bool  `protobuf:"varint,2,opt,name=force,proto3" json:"force,omitempty"`
+}
+
+func (x *ExplainLinksRequest) Reset() {
+	*x = ExplainLinksRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cdprocessor_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExplainLinksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExplainLinksRequest) ProtoMessage() {}
+
+func (x *ExplainLinksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cdprocessor_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExplainLinksRequest.ProtoReflect.Descriptor instead.
+func (*ExplainLinksRequest) Descriptor() ([]byte, []int) {
+	return file_cdprocessor_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ExplainLinksRequest) GetInstanceId() int32 {
+	if x != nil {
+		return x.InstanceId
+	}
+	return 0
+}
+
+func (x *ExplainLinksRequest) GetForce() bool {
+	if x != nil {
+		return x.Force
+	}
+	return false
+}
+
+type ExplainLinksResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Rules []*LinkRule `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules,omitempty"`
+	// The name of the rule that stopped processing, empty if none did
+	Fired string `protobuf:"bytes,2,opt,name=fired,proto3" json:"fired,omitempty"`
+	// Whether the links would be forced
+	Force   bool     `protobuf:"varint,3,opt,name=force,proto3" json:"force,omitempty"`
+	Actions []string `protobuf:"bytes,4,rep,name=actions,proto3" json:"actions,omitempty"`
+}
+
+func (x *ExplainLinksResponse) Reset() {
+	*x = ExplainLinksResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cdprocessor_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExplainLinksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExplainLinksResponse) ProtoMessage() {}
+
+func (x *ExplainLinksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cdprocessor_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExplainLinksResponse.ProtoReflect.Descriptor instead.
+func (*ExplainLinksResponse) Descriptor() ([]byte, []int) {
+	return file_cdprocessor_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ExplainLinksResponse) GetRules() []*LinkRule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+func (x *ExplainLinksResponse) GetFired() string {
+	if x != nil {
+		return x.Fired
+	}
+	return ""
+}
+
+func (x *ExplainLinksResponse) GetForce() bool {
+	if x != nil {
+		return x.Force
+	}
+	return false
+}
+
+func (x *ExplainLinksResponse) GetActions() []string {
+	if x != nil {
+		return x.Actions
+	}
+	return nil
+}
+
+type BulkForceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Defaults to RECREATE_LINKS
+	Type ForceRequest_ForceType `protobuf:"varint,1,opt,name=type,proto3,enum=cdprocessor.ForceRequest_ForceType" json:"type,omitempty"`
+	// Records are selected from any of these
+	ReleaseId   int32   `protobuf:"varint,2,opt,name=release_id,json=releaseId,proto3" json:"release_id,omitempty"`
+	FolderId    int32   `protobuf:"varint,3,opt,name=folder_id,json=folderId,proto3" json:"folder_id,omitempty"`
+	InstanceIds []int32 `protobuf:"varint,4,rep,packed,name=instance_ids,json=instanceIds,proto3" json:"instance_ids,omitempty"`
+	// The number of forces to run at once, defaults to 4. Relinks still go one at a time.
+	Concurrency int32 `protobuf:"varint,5,opt,name=concurrency,proto3" json:"concurrency,omitempty"`
+}
+
+func (x *BulkForceRequest) Reset() {
+	*x = BulkForceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cdprocessor_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BulkForceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkForceRequest) ProtoMessage() {}
+
+func (x *BulkForceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cdprocessor_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkForceRequest.ProtoReflect.Descriptor instead.
+func (*BulkForceRequest) Descriptor() ([]byte, []int) {
+	return file_cdprocessor_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *BulkForceRequest) GetType() ForceRequest_ForceType {
+	if x != nil {
+		return x.Type
+	}
+	return ForceRequest_UNKNOWN
+}
+
+func (x *BulkForceRequest) GetReleaseId() int32 {
+	if x != nil {
+		return x.ReleaseId
+	}
+	return 0
+}
+
+func (x *BulkForceRequest) GetFolderId() int32 {
+	if x != nil {
+		return x.FolderId
+	}
+	return 0
+}
+
+func (x *BulkForceRequest) GetInstanceIds() []int32 {
+	if x != nil {
+		return x.InstanceIds
+	}
+	return nil
+}
+
+func (x *BulkForceRequest) GetConcurrency() int32 {
+	if x != nil {
+		return x.Concurrency
+	}
+	return 0
+}
+
+type ForceResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	InstanceId int32 `protobuf:"varint,1,opt,name=instance_id,json=instanceId,proto3" json:"instance_id,omitempty"`
+	// Empty if the force succeeded
+	Error string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *ForceResult) Reset() {
+	*x = ForceResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cdprocessor_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ForceResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForceResult) ProtoMessage() {}
+
+func (x *ForceResult) ProtoReflect() protoreflect.Message {
+	mi := &file_cdprocessor_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForceResult.ProtoReflect.Descriptor instead.
+func (*ForceResult) Descriptor() ([]byte, []int) {
+	return file_cdprocessor_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ForceResult) GetInstanceId() int32 {
+	if x != nil {
+		return x.InstanceId
+	}
+	return 0
+}
+
+func (x *ForceResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type BulkForceResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Results []*ForceResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+}
+
+func (x *BulkForceResponse) Reset() {
+	*x = BulkForceResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cdprocessor_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BulkForceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkForceResponse) ProtoMessage() {}
+
+func (x *BulkForceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cdprocessor_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkForceResponse.ProtoReflect.Descriptor instead.
+func (*BulkForceResponse) Descriptor() ([]byte, []int) {
+	return file_cdprocessor_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *BulkForceResponse) GetResults() []*ForceResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type EnqueueRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	InstanceId int32  `protobuf:"varint,1,opt,name=instance_id,json=instanceId,proto3" json:"instance_id,omitempty"`
+	Reason     string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *EnqueueRequest) Reset() {
+	*x = EnqueueRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cdprocessor_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EnqueueRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnqueueRequest) ProtoMessage() {}
+
+func (x *EnqueueRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cdprocessor_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnqueueRequest.ProtoReflect.Descriptor instead.
+func (*EnqueueRequest) Descriptor() ([]byte, []int) {
+	return file_cdprocessor_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *EnqueueRequest) GetInstanceId() int32 {
+	if x != nil {
+		return x.InstanceId
+	}
+	return 0
+}
+
+func (x *EnqueueRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type EnqueueResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Entry *QueueEntry `protobuf:"bytes,1,opt,name=entry,proto3" json:"entry,omitempty"`
+}
+
+func (x *EnqueueResponse) Reset() {
+	*x = EnqueueResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cdprocessor_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EnqueueResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnqueueResponse) ProtoMessage() {}
+
+func (x *EnqueueResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cdprocessor_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnqueueResponse.ProtoReflect.Descriptor instead.
+func (*EnqueueResponse) Descriptor() ([]byte, []int) {
+	return file_cdprocessor_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *EnqueueResponse) GetEntry() *QueueEntry {
+	if x != nil {
+		return x.Entry
+	}
+	return nil
+}
+
+type DequeueRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	InstanceId int32  `protobuf:"varint,1,opt,name=instance_id,json=instanceId,proto3" json:"instance_id,omitempty"`
+	Reason     string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *DequeueRequest) Reset() {
+	*x = DequeueRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cdprocessor_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DequeueRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DequeueRequest) ProtoMessage() {}
+
+func (x *DequeueRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cdprocessor_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DequeueRequest.ProtoReflect.Descriptor instead.
+func (*DequeueRequest) Descriptor() ([]byte, []int) {
+	return file_cdprocessor_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *DequeueRequest) GetInstanceId() int32 {
+	if x != nil {
+		return x.InstanceId
+	}
+	return 0
+}
+
+func (x *DequeueRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type DequeueResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DequeueResponse) Reset() {
+	*x = DequeueResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cdprocessor_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DequeueResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DequeueResponse) ProtoMessage() {}
+
+func (x *DequeueResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cdprocessor_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DequeueResponse.ProtoReflect.Descriptor instead.
+func (*DequeueResponse) Descriptor() ([]byte, []int) {
+	return file_cdprocessor_proto_rawDescGZIP(), []int{34}
+}
+
+type SnoozeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	InstanceId int32  `protobuf:"varint,1,opt,name=instance_id,json=instanceId,proto3" json:"instance_id,omitempty"`
+	Until      int64  `protobuf:"varint,2,opt,name=until,proto3" json:"until,omitempty"`
+	Reason     string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *SnoozeRequest) Reset() {
+	*x = SnoozeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cdprocessor_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SnoozeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SnoozeRequest) ProtoMessage() {}
+
+func (x *SnoozeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cdprocessor_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SnoozeRequest.ProtoReflect.Descriptor instead.
+func (*SnoozeRequest) Descriptor() ([]byte, []int) {
+	return file_cdprocessor_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *SnoozeRequest) GetInstanceId() int32 {
+	if x != nil {
+		return x.InstanceId
+	}
+	return 0
+}
+
+func (x *SnoozeRequest) GetUntil() int64 {
+	if x != nil {
+		return x.Until
+	}
+	return 0
+}
+
+func (x *SnoozeRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type SnoozeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Entry *QueueEntry `protobuf:"bytes,1,opt,name=entry,proto3" json:"entry,omitempty"`
+}
+
+func (x *SnoozeResponse) Reset() {
+	*x = SnoozeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cdprocessor_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SnoozeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SnoozeResponse) ProtoMessage() {}
+
+func (x *SnoozeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cdprocessor_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SnoozeResponse.ProtoReflect.Descriptor instead.
+func (*SnoozeResponse) Descriptor() ([]byte, []int) {
+	return file_cdprocessor_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *SnoozeResponse) GetEntry() *QueueEntry {
+	if x != nil {
+		return x.Entry
+	}
+	return nil
+}
+
+type GetTagPlanRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	InstanceId int32 `protobuf:"varint,1,opt,name=instance_id,json=instanceId,proto3" json:"instance_id,omitempty"`
+}
+
+func (x *GetTagPlanRequest) Reset() {
+	*x = GetTagPlanRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cdprocessor_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTagPlanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTagPlanRequest) ProtoMessage() {}
+
+func (x *GetTagPlanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cdprocessor_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTagPlanRequest.ProtoReflect.Descriptor instead.
+func (*GetTagPlanRequest) Descriptor() ([]byte, []int) {
+	return file_cdprocessor_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *GetTagPlanRequest) GetInstanceId() int32 {
+	if x != nil {
+		return x.InstanceId
+	}
+	return 0
+}
+
+type FileOperation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// One of cover, link or tag
+	Stage   string   `protobuf:"bytes,1,opt,name=stage,proto3" json:"stage,omitempty"`
+	Command []string `protobuf:"bytes,2,rep,name=command,proto3" json:"command,omitempty"`
+}
+
+func (x *FileOperation) Reset() {
+	*x = FileOperation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cdprocessor_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FileOperation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FileOperation) ProtoMessage() {}
+
+func (x *FileOperation) ProtoReflect() protoreflect.Message {
+	mi := &file_cdprocessor_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FileOperation.ProtoReflect.Descriptor instead.
+func (*FileOperation) Descriptor() ([]byte, []int) {
+	return file_cdprocessor_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *FileOperation) GetStage() string {
+	if x != nil {
+		return x.Stage
+	}
+	return ""
+}
+
+func (x *FileOperation) GetCommand() []string {
+	if x != nil {
+		return x.Command
+	}
+	return nil
+}
+
+type TrackPlan struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Disk       string `protobuf:"bytes,1,opt,name=disk,proto3" json:"disk,omitempty"`
+	Position   string `protobuf:"bytes,2,opt,name=position,proto3" json:"position,omitempty"`
+	Title      string `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Artist     string `protobuf:"bytes,4,opt,name=artist,proto3" json:"artist,omitempty"`
+	Album      string `protobuf:"bytes,5,opt,name=album,proto3" json:"album,omitempty"`
+	DiscNumber string `protobuf:"bytes,6,opt,name=disc_number,json=discNumber,proto3" json:"disc_number,omitempty"`
+	// The ripped flac the plan works from and whether it's there
+	Source       string           `protobuf:"bytes,7,opt,name=source,proto3" json:"source,omitempty"`
+	SourceExists bool             `protobuf:"varint,8,opt,name=source_exists,json=sourceExists,proto3" json:"source_exists,omitempty"`
+	Mp3Target    string           `protobuf:"bytes,9,opt,name=mp3_target,json=mp3Target,proto3" json:"mp3_target,omitempty"`
+	FlacTarget   string           `protobuf:"bytes,10,opt,name=flac_target,json=flacTarget,proto3" json:"flac_target,omitempty"`
+	Operations   []*FileOperation `protobuf:"bytes,11,rep,name=operations,proto3" json:"operations,omitempty"`
+}
+
+func (x *TrackPlan) Reset() {
+	*x = TrackPlan{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cdprocessor_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TrackPlan) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrackPlan) ProtoMessage() {}
+
+func (x *TrackPlan) ProtoReflect() protoreflect.Message {
+	mi := &file_cdprocessor_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrackPlan.ProtoReflect.Descriptor instead.
+func (*TrackPlan) Descriptor() ([]byte, []int) {
+	return file_cdprocessor_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *TrackPlan) GetDisk() string {
+	if x != nil {
+		return x.Disk
+	}
+	return ""
+}
+
+func (x *TrackPlan) GetPosition() string {
+	if x != nil {
+		return x.Position
+	}
+	return ""
+}
+
+func (x *TrackPlan) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *TrackPlan) GetArtist() string {
+	if x != nil {
+		return x.Artist
+	}
+	return ""
+}
+
+func (x *TrackPlan) GetAlbum() string {
+	if x != nil {
+		return x.Album
+	}
+	return ""
+}
+
+func (x *TrackPlan) GetDiscNumber() string {
+	if x != nil {
+		return x.DiscNumber
+	}
+	return ""
+}
+
+func (x *TrackPlan) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *TrackPlan) GetSourceExists() bool {
+	if x != nil {
+		return x.SourceExists
+	}
+	return false
+}
+
+func (x *TrackPlan) GetMp3Target() string {
+	if x != nil {
+		return x.Mp3Target
+	}
+	return ""
+}
+
+func (x *TrackPlan) GetFlacTarget() string {
+	if x != nil {
+		return x.FlacTarget
+	}
+	return ""
+}
+
+func (x *TrackPlan) GetOperations() []*FileOperation {
+	if x != nil {
+		return x.Operations
+	}
+	return nil
+}
+
+type GetTagPlanResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tracks []*TrackPlan `protobuf:"bytes,1,rep,name=tracks,proto3" json:"tracks,omitempty"`
+}
+
+func (x *GetTagPlanResponse) Reset() {
+	*x = GetTagPlanResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cdprocessor_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTagPlanResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTagPlanResponse) ProtoMessage() {}
+
+func (x *GetTagPlanResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cdprocessor_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTagPlanResponse.ProtoReflect.Descriptor instead.
+func (*GetTagPlanResponse) Descriptor() ([]byte, []int) {
+	return file_cdprocessor_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *GetTagPlanResponse) GetTracks() []*TrackPlan {
+	if x != nil {
+		return x.Tracks
+	}
+	return nil
+}
+
+type CompactConfigRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Report what would be removed without removing it
+	DryRun bool `protobuf:"varint,1,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+}
+
+func (x *CompactConfigRequest) Reset() {
+	*x = CompactConfigRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cdprocessor_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CompactConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompactConfigRequest) ProtoMessage() {}
+
+func (x *CompactConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cdprocessor_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompactConfigRequest.ProtoReflect.Descriptor instead.
+func (*CompactConfigRequest) Descriptor() ([]byte, []int) {
+	return file_cdprocessor_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *CompactConfigRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type CompactConfigResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Report *CompactionReport `protobuf:"bytes,1,opt,name=report,proto3" json:"report,omitempty"`
+}
+
+func (x *CompactConfigResponse) Reset() {
+	*x = CompactConfigResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cdprocessor_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CompactConfigResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompactConfigResponse) ProtoMessage() {}
+
+func (x *CompactConfigResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cdprocessor_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompactConfigResponse.ProtoReflect.Descriptor instead.
+func (*CompactConfigResponse) Descriptor() ([]byte, []int) {
+	return file_cdprocessor_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *CompactConfigResponse) GetReport() *CompactionReport {
+	if x != nil {
+		return x.Report
+	}
+	return nil
+}
+
+type HistoryEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type       HistoryEntry_Type `protobuf:"varint,1,opt,name=type,proto3,enum=cdprocessor.HistoryEntry_Type" json:"type,omitempty"`
+	Timestamp  int64             `protobuf:"varint,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	InstanceId int32             `protobuf:"varint,3,opt,name=instance_id,json=instanceId,proto3" json:"instance_id,omitempty"`
+	Detail     string            `protobuf:"bytes,4,opt,name=detail,proto3" json:"detail,omitempty"`
+	// The error text, when something went wrong
+	Error string `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *HistoryEntry) Reset() {
+	*x = HistoryEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cdprocessor_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HistoryEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HistoryEntry) ProtoMessage() {}
+
+func (x *HistoryEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_cdprocessor_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HistoryEntry.ProtoReflect.Descriptor instead.
+func (*HistoryEntry) Descriptor() ([]byte, []int) {
+	return file_cdprocessor_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *HistoryEntry) GetType() HistoryEntry_Type {
+	if x != nil {
+		return x.Type
+	}
+	return HistoryEntry_UNKNOWN
+}
+
+func (x *HistoryEntry) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *HistoryEntry) GetInstanceId() int32 {
+	if x != nil {
+		return x.InstanceId
+	}
+	return 0
+}
+
+func (x *HistoryEntry) GetDetail() string {
+	if x != nil {
+		return x.Detail
+	}
+	return ""
+}
+
+func (x *HistoryEntry) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type RecordHistory struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Oldest first, trimmed to the most recent entries
+	Entries []*HistoryEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+}
+
+func (x *RecordHistory) Reset() {
+	*x = RecordHistory{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cdprocessor_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RecordHistory) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordHistory) ProtoMessage() {}
+
+func (x *RecordHistory) ProtoReflect() protoreflect.Message {
+	mi := &file_cdprocessor_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordHistory.ProtoReflect.Descriptor instead.
+func (*RecordHistory) Descriptor() ([]byte, []int) {
+	return file_cdprocessor_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *RecordHistory) GetEntries() []*HistoryEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+type History struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Keyed by release id, since rips on disk only know the release
+	Records map[int32]*RecordHistory `protobuf:"bytes,1,rep,name=records,proto3" json:"records,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *History) Reset() {
+	*x = History{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cdprocessor_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *History) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*History) ProtoMessage() {}
+
+func (x *History) ProtoReflect() protoreflect.Message {
+	mi := &file_cdprocessor_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use History.ProtoReflect.Descriptor instead.
+func (*History) Descriptor() ([]byte, []int) {
+	return file_cdprocessor_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *History) GetRecords() map[int32]*RecordHistory {
+	if x != nil {
+		return x.Records
+	}
+	return nil
+}
+
+type GetHistoryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// A release id, or an instance id
+	Id int32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Only return the most recent max entries, 0 returns everything
+	Max int32 `protobuf:"varint,2,opt,name=max,proto3" json:"max,omitempty"`
+}
+
+func (x *GetHistoryRequest) Reset() {
+	*x = GetHistoryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cdprocessor_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHistoryRequest) ProtoMessage() {}
+
+func (x *GetHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cdprocessor_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_cdprocessor_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *GetHistoryRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *GetHistoryRequest) GetMax() int32 {
+	if x != nil {
+		return x.Max
+	}
+	return 0
+}
+
+type GetHistoryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Entries []*HistoryEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+}
+
+func (x *GetHistoryResponse) Reset() {
+	*x = GetHistoryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cdprocessor_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHistoryResponse) ProtoMessage() {}
+
+func (x *GetHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cdprocessor_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_cdprocessor_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *GetHistoryResponse) GetEntries() []*HistoryEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+type ExportConfigRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ExportConfigRequest) Reset() {
+	*x = ExportConfigRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cdprocessor_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportConfigRequest) ProtoMessage() {}
+
+func (x *ExportConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cdprocessor_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportConfigRequest.ProtoReflect.Descriptor instead.
+func (*ExportConfigRequest) Descriptor() ([]byte, []int) {
+	return file_cdprocessor_proto_rawDescGZIP(), []int{48}
+}
+
+type ExportConfigResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Config *Config `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
+}
+
+func (x *ExportConfigResponse) Reset() {
+	*x = ExportConfigResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cdprocessor_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportConfigResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportConfigResponse) ProtoMessage() {}
+
+func (x *ExportConfigResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cdprocessor_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportConfigResponse.ProtoReflect.Descriptor instead.
+func (*ExportConfigResponse) Descriptor() ([]byte, []int) {
+	return file_cdprocessor_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *ExportConfigResponse) GetConfig() *Config {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+type ImportConfigRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Replaces the live config
+	Config *Config `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
+	// Validate and report the differences without saving
+	DryRun bool `protobuf:"varint,2,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+}
+
+func (x *ImportConfigRequest) Reset() {
+	*x = ImportConfigRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cdprocessor_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportConfigRequest) ProtoMessage() {}
+
+func (x *ImportConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cdprocessor_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportConfigRequest.ProtoReflect.Descriptor instead.
+func (*ImportConfigRequest) Descriptor() ([]byte, []int) {
+	return file_cdprocessor_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *ImportConfigRequest) GetConfig() *Config {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+func (x *ImportConfigRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type ImportConfigResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// One line per changed field or map entry
+	Diff []string `protobuf:"bytes,1,rep,name=diff,proto3" json:"diff,omitempty"`
+}
+
+func (x *ImportConfigResponse) Reset() {
+	*x = ImportConfigResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cdprocessor_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportConfigResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportConfigResponse) ProtoMessage() {}
+
+func (x *ImportConfigResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cdprocessor_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportConfigResponse.ProtoReflect.Descriptor instead.
+func (*ImportConfigResponse) Descriptor() ([]byte, []int) {
+	return file_cdprocessor_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *ImportConfigResponse) GetDiff() []string {
+	if x != nil {
+		return x.Diff
+	}
+	return nil
+}
+
+type RipProblem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Kind  RipProblem_Kind `protobuf:"varint,1,opt,name=kind,proto3,enum=cdprocessor.RipProblem_Kind" json:"kind,omitempty"`
+	RipId int32           `protobuf:"varint,2,opt,name=rip_id,json=ripId,proto3" json:"rip_id,omitempty"`
+	// The release the rip belongs to, when we could work it out
+	ReleaseId int32    `protobuf:"varint,3,opt,name=release_id,json=releaseId,proto3" json:"release_id,omitempty"`
+	Paths     []string `protobuf:"bytes,4,rep,name=paths,proto3" json:"paths,omitempty"`
+	Detail    string   `protobuf:"bytes,5,opt,name=detail,proto3" json:"detail,omitempty"`
+}
+
+func (x *RipProblem) Reset() {
+	*x = RipProblem{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cdprocessor_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RipProblem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RipProblem) ProtoMessage() {}
+
+func (x *RipProblem) ProtoReflect() protoreflect.Message {
+	mi := &file_cdprocessor_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RipProblem.ProtoReflect.Descriptor instead.
+func (*RipProblem) Descriptor() ([]byte, []int) {
+	return file_cdprocessor_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *RipProblem) GetKind() RipProblem_Kind {
+	if x != nil {
+		return x.Kind
+	}
+	return RipProblem_UNKNOWN
+}
+
+func (x *RipProblem) GetRipId() int32 {
+	if x != nil {
+		return x.RipId
+	}
+	return 0
+}
+
+func (x *RipProblem) GetReleaseId() int32 {
+	if x != nil {
+		return x.ReleaseId
+	}
+	return 0
+}
+
+func (x *RipProblem) GetPaths() []string {
+	if x != nil {
+		return x.Paths
+	}
+	return nil
+}
+
+func (x *RipProblem) GetDetail() string {
+	if x != nil {
+		return x.Detail
+	}
+	return ""
+}
+
+type RipAudit struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RunTime  int64         `protobuf:"varint,1,opt,name=run_time,json=runTime,proto3" json:"run_time,omitempty"`
+	Checked  int32         `protobuf:"varint,2,opt,name=checked,proto3" json:"checked,omitempty"`
+	Problems []*RipProblem `protobuf:"bytes,3,rep,name=problems,proto3" json:"problems,omitempty"`
+	// Rip ids we couldn't look up, with the error as the detail
+	Skipped []*RipProblem `protobuf:"bytes,4,rep,name=skipped,proto3" json:"skipped,omitempty"`
+}
+
+func (x *RipAudit) Reset() {
+	*x = RipAudit{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cdprocessor_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RipAudit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RipAudit) ProtoMessage() {}
+
+func (x *RipAudit) ProtoReflect() protoreflect.Message {
+	mi := &file_cdprocessor_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RipAudit.ProtoReflect.Descriptor instead.
+func (*RipAudit) Descriptor() ([]byte, []int) {
+	return file_cdprocessor_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *RipAudit) GetRunTime() int64 {
+	if x != nil {
+		return x.RunTime
+	}
+	return 0
+}
+
+func (x *RipAudit) GetChecked() int32 {
+	if x != nil {
+		return x.Checked
+	}
+	return 0
+}
+
+func (x *RipAudit) GetProblems() []*RipProblem {
+	if x != nil {
+		return x.Problems
+	}
+	return nil
+}
+
+func (x *RipAudit) GetSkipped() []*RipProblem {
+	if x != nil {
+		return x.Skipped
+	}
+	return nil
+}
+
+type AuditRipsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *AuditRipsRequest) Reset() {
+	*x = AuditRipsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cdprocessor_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuditRipsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditRipsRequest) ProtoMessage() {}
+
+func (x *AuditRipsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cdprocessor_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditRipsRequest.ProtoReflect.Descriptor instead.
+func (*AuditRipsRequest) Descriptor() ([]byte, []int) {
+	return file_cdprocessor_proto_rawDescGZIP(), []int{54}
+}
+
+type AuditRipsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Audit *RipAudit `protobuf:"bytes,1,opt,name=audit,proto3" json:"audit,omitempty"`
+}
+
+func (x *AuditRipsResponse) Reset() {
+	*x = AuditRipsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cdprocessor_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuditRipsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditRipsResponse) ProtoMessage() {}
+
+func (x *AuditRipsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cdprocessor_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditRipsResponse.ProtoReflect.Descriptor instead.
+func (*AuditRipsResponse) Descriptor() ([]byte, []int) {
+	return file_cdprocessor_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *AuditRipsResponse) GetAudit() *RipAudit {
+	if x != nil {
+		return x.Audit
+	}
+	return nil
+}
+
+type FileChecksum struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The rip (or release) id the file belongs to
+	Id    int32  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Path  string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	Size  int64  `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	Mtime int64  `protobuf:"varint,4,opt,name=mtime,proto3" json:"mtime,omitempty"`
+	// Empty until the file has been hashed in the background
+	Sha256 string `protobuf:"bytes,5,opt,name=sha256,proto3" json:"sha256,omitempty"`
+	// The audio MD5 from a flac's STREAMINFO, which retagging leaves alone
+	FlacMd5      string `protobuf:"bytes,6,opt,name=flac_md5,json=flacMd5,proto3" json:"flac_md5,omitempty"`
+	Recorded     int64  `protobuf:"varint,7,opt,name=recorded,proto3" json:"recorded,omitempty"`
+	LastScrubbed int64  `protobuf:"varint,8,opt,name=last_scrubbed,json=lastScrubbed,proto3" json:"last_scrubbed,omitempty"`
+	// Set when the last scrub found the file missing or changed
+	Problem string `protobuf:"bytes,9,opt,name=problem,proto3" json:"problem,omitempty"`
+}
+
+func (x *FileChecksum) Reset() {
+	*x = FileChecksum{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cdprocessor_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FileChecksum) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FileChecksum) ProtoMessage() {}
+
+func (x *FileChecksum) ProtoReflect() protoreflect.Message {
+	mi := &file_cdprocessor_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FileChecksum.ProtoReflect.Descriptor instead.
+func (*FileChecksum) Descriptor() ([]byte, []int) {
+	return file_cdprocessor_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *FileChecksum) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *FileChecksum) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *FileChecksum) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *FileChecksum) GetMtime() int64 {
+	if x != nil {
+		return x.Mtime
+	}
+	return 0
+}
+
+func (x *FileChecksum) GetSha256() string {
+	if x != nil {
+		return x.Sha256
+	}
+	return ""
+}
+
+func (x *FileChecksum) GetFlacMd5() string {
+	if x != nil {
+		return x.FlacMd5
+	}
+	return ""
+}
+
+func (x *FileChecksum) GetRecorded() int64 {
+	if x != nil {
+		return x.Recorded
+	}
+	return 0
+}
+
+func (x *FileChecksum) GetLastScrubbed() int64 {
+	if x != nil {
+		return x.LastScrubbed
+	}
+	return 0
+}
+
+func (x *FileChecksum) GetProblem() string {
+	if x != nil {
+		return x.Problem
+	}
+	return ""
+}
+
+type Manifest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Keyed on path under the rips directory
+	Files map[string]*FileChecksum `protobuf:"bytes,1,rep,name=files,proto3" json:"files,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *Manifest) Reset() {
+	*x = Manifest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cdprocessor_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Manifest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Manifest) ProtoMessage() {}
+
+func (x *Manifest) ProtoReflect() protoreflect.Message {
+	mi := &file_cdprocessor_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Manifest.ProtoReflect.Descriptor instead.
+func (*Manifest) Descriptor() ([]byte, []int) {
+	return file_cdprocessor_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *Manifest) GetFiles() map[string]*FileChecksum {
+	if x != nil {
+		return x.Files
+	}
+	return nil
+}
+
+type ScrubProgress struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StartTime int64  `protobuf:"varint,1,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	Files     int32  `protobuf:"varint,2,opt,name=files,proto3" json:"files,omitempty"`
+	Scrubbed  int32  `protobuf:"varint,3,opt,name=scrubbed,proto3" json:"scrubbed,omitempty"`
+	Bytes     int64  `protobuf:"varint,4,opt,name=bytes,proto3" json:"bytes,omitempty"`
+	Problems  int32  `protobuf:"varint,5,opt,name=problems,proto3" json:"problems,omitempty"`
+	Current   string `protobuf:"bytes,6,opt,name=current,proto3" json:"current,omitempty"`
+	// When the last full pass finished, and how long it took
+	LastFinished        int64 `protobuf:"varint,7,opt,name=last_finished,json=lastFinished,proto3" json:"last_finished,omitempty"`
+	LastDurationSeconds int64 `protobuf:"varint,8,opt,name=last_duration_seconds,json=lastDurationSeconds,proto3" json:"last_duration_seconds,omitempty"`
+}
+
+func (x *ScrubProgress) Reset() {
+	*x = ScrubProgress{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cdprocessor_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ScrubProgress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScrubProgress) ProtoMessage() {}
+
+func (x *ScrubProgress) ProtoReflect() protoreflect.Message {
+	mi := &file_cdprocessor_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScrubProgress.ProtoReflect.Descriptor instead.
+func (*ScrubProgress) Descriptor() ([]byte, []int) {
+	return file_cdprocessor_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *ScrubProgress) GetStartTime() int64 {
+	if x != nil {
+		return x.StartTime
+	}
+	return 0
+}
+
+func (x *ScrubProgress) GetFiles() int32 {
+	if x != nil {
+		return x.Files
+	}
+	return 0
+}
+
+func (x *ScrubProgress) GetScrubbed() int32 {
+	if x != nil {
+		return x.Scrubbed
+	}
+	return 0
+}
+
+func (x *ScrubProgress) GetBytes() int64 {
+	if x != nil {
+		return x.Bytes
+	}
+	return 0
+}
+
+func (x *ScrubProgress) GetProblems() int32 {
+	if x != nil {
+		return x.Problems
+	}
+	return 0
+}
+
+func (x *ScrubProgress) GetCurrent() string {
+	if x != nil {
+		return x.Current
+	}
+	return ""
+}
+
+func (x *ScrubProgress) GetLastFinished() int64 {
+	if x != nil {
+		return x.LastFinished
+	}
+	return 0
+}
+
+func (x *ScrubProgress) GetLastDurationSeconds() int64 {
+	if x != nil {
+		return x.LastDurationSeconds
+	}
+	return 0
+}
+
+type GetScrubProgressRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetScrubProgressRequest) Reset() {
+	*x = GetScrubProgressRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cdprocessor_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetScrubProgressRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetScrubProgressRequest) ProtoMessage() {}
+
+func (x *GetScrubProgressRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cdprocessor_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetScrubProgressRequest.ProtoReflect.Descriptor instead.
+func (*GetScrubProgressRequest) Descriptor() ([]byte, []int) {
+	return file_cdprocessor_proto_rawDescGZIP(), []int{59}
+}
+
+type GetScrubProgressResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Progress *ScrubProgress  `protobuf:"bytes,1,opt,name=progress,proto3" json:"progress,omitempty"`
+	Problems []*FileChecksum `protobuf:"bytes,2,rep,name=problems,proto3" json:"problems,omitempty"`
+}
+
+func (x *GetScrubProgressResponse) Reset() {
+	*x = GetScrubProgressResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cdprocessor_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetScrubProgressResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetScrubProgressResponse) ProtoMessage() {}
+
+func (x *GetScrubProgressResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cdprocessor_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetScrubProgressResponse.ProtoReflect.Descriptor instead.
+func (*GetScrubProgressResponse) Descriptor() ([]byte, []int) {
+	return file_cdprocessor_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *GetScrubProgressResponse) GetProgress() *ScrubProgress {
+	if x != nil {
+		return x.Progress
+	}
+	return nil
+}
+
+func (x *GetScrubProgressResponse) GetProblems() []*FileChecksum {
+	if x != nil {
+		return x.Problems
+	}
+	return nil
+}
+
+var File_cdprocessor_proto protoreflect.FileDescriptor
+
+var file_cdprocessor_proto_rawDesc = []byte{
+	0x0a, 0x11, 0x63, 0x64, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x12, 0x0b, 0x63, 0x64, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72,
+	0x1a, 0x45, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x72, 0x6f,
+	0x74, 0x68, 0x65, 0x72, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2f, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64,
+	0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2f, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xc2, 0x09, 0x0a, 0x06, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x12, 0x54, 0x0a, 0x11, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x70, 0x72, 0x6f, 0x63, 0x65,
+	0x73, 0x73, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e,
+	0x63, 0x64, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x2e, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x2e, 0x4c, 0x61, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x54, 0x69,
+	0x6d, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0f, 0x6c, 0x61, 0x73, 0x74, 0x50, 0x72, 0x6f,
+	0x63, 0x65, 0x73, 0x73, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x4a, 0x0a, 0x0d, 0x69, 0x73, 0x73, 0x75,
+	0x65, 0x5f, 0x6d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x25, 0x2e, 0x63, 0x64, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x2e, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x2e, 0x49, 0x73, 0x73, 0x75, 0x65, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e,
+	0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0c, 0x69, 0x73, 0x73, 0x75, 0x65, 0x4d, 0x61, 0x70,
+	0x70, 0x69, 0x6e, 0x67, 0x12, 0x48, 0x0a, 0x0d, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x72, 0x69, 0x70,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x63, 0x64,
+	0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x2e, 0x4c, 0x61, 0x73, 0x74, 0x52, 0x69, 0x70, 0x54, 0x69, 0x6d, 0x65, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x52, 0x0b, 0x6c, 0x61, 0x73, 0x74, 0x52, 0x69, 0x70, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x44,
+	0x0a, 0x0b, 0x67, 0x6f, 0x61, 0x6c, 0x5f, 0x66, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x18, 0x04, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x63, 0x64, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x6f,
+	0x72, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x47, 0x6f, 0x61, 0x6c, 0x46, 0x6f, 0x6c,
+	0x64, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x67, 0x6f, 0x61, 0x6c, 0x46, 0x6f,
+	0x6c, 0x64, 0x65, 0x72, 0x12, 0x13, 0x0a, 0x05, 0x74, 0x6f, 0x5f, 0x67, 0x6f, 0x18, 0x05, 0x20,
+	0x03, 0x28, 0x05, 0x52, 0x04, 0x74, 0x6f, 0x47, 0x6f, 0x12, 0x45, 0x0a, 0x0c, 0x74, 0x6f, 0x5f,
+	0x67, 0x6f, 0x5f, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x23, 0x2e, 0x63, 0x64, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x2e, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x2e, 0x54, 0x6f, 0x47, 0x6f, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x74, 0x6f, 0x47, 0x6f, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c,
+	0x12, 0x46, 0x0a, 0x0b, 0x6f, 0x75, 0x74, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x18,
+	0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x63, 0x64, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73,
+	0x73, 0x6f, 0x72, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x4f, 0x75, 0x74, 0x73, 0x74,
+	0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0b, 0x6f, 0x75, 0x74,
+	0x73, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x63, 0x68, 0x65,
+	0x6d, 0x61, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0d, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12,
+	0x46, 0x0a, 0x0f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x63, 0x64, 0x70, 0x72, 0x6f,
+	0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x0e, 0x6c, 0x61, 0x73, 0x74, 0x43, 0x6f, 0x6d,
+	0x70, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73,
+	0x69, 0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73,
+	0x69, 0x6f, 0x6e, 0x12, 0x43, 0x0a, 0x0a, 0x73, 0x75, 0x70, 0x70, 0x72, 0x65, 0x73, 0x73, 0x65,
+	0x64, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x63, 0x64, 0x70, 0x72, 0x6f, 0x63,
+	0x65, 0x73, 0x73, 0x6f, 0x72, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x53, 0x75, 0x70,
+	0x70, 0x72, 0x65, 0x73, 0x73, 0x65, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x73, 0x75,
+	0x70, 0x70, 0x72, 0x65, 0x73, 0x73, 0x65, 0x64, 0x1a, 0x42, 0x0a, 0x14, 0x4c, 0x61, 0x73, 0x74,
+	0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x54, 0x69, 0x6d, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x3f, 0x0a, 0x11,
+	0x49, 0x73, 0x73, 0x75, 0x65, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x3e, 0x0a,
+	0x10, 0x4c, 0x61, 0x73, 0x74, 0x52, 0x69, 0x70, 0x54, 0x69, 0x6d, 0x65, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x3d, 0x0a,
+	0x0f, 0x47, 0x6f, 0x61, 0x6c, 0x46, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x56, 0x0a, 0x0f,
+	0x54, 0x6f, 0x47, 0x6f, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x2d, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x17, 0x2e, 0x63, 0x64, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x2e, 0x51,
+	0x75, 0x65, 0x75, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x3a, 0x02, 0x38, 0x01, 0x1a, 0x58, 0x0a, 0x10, 0x4f, 0x75, 0x74, 0x73, 0x74, 0x61, 0x6e, 0x64,
+	0x69, 0x6e, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2e, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x63, 0x64, 0x70, 0x72,
+	0x6f, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x2e, 0x4f, 0x75, 0x74, 0x73, 0x74, 0x61, 0x6e, 0x64,
+	0x69, 0x6e, 0x67, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x5c,
+	0x0a, 0x0f, 0x53, 0x75, 0x70, 0x70, 0x72, 0x65, 0x73, 0x73, 0x65, 0x64, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x33, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x63, 0x64, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72,
+	0x2e, 0x51, 0x75, 0x65, 0x75, 0x65, 0x53, 0x75, 0x70, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x5f, 0x0a, 0x10,
+	0x51, 0x75, 0x65, 0x75, 0x65, 0x53, 0x75, 0x70, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49,
+	0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x6d,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x22, 0x62, 0x0a,
+	0x0f, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x65, 0x64, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64,
+	0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49,
+	0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x69, 0x65,
+	0x6c, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64,
+	0x73, 0x22, 0xd0, 0x01, 0x0a, 0x10, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x75, 0x6e, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x72, 0x75, 0x6e, 0x54, 0x69, 0x6d,
+	0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x07, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x64, 0x12, 0x36, 0x0a, 0x07, 0x72,
+	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63,
+	0x64, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x61,
+	0x63, 0x74, 0x65, 0x64, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x07, 0x72, 0x65, 0x6d, 0x6f,
+	0x76, 0x65, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x72, 0x79, 0x5f, 0x72, 0x75, 0x6e, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x64, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x12, 0x36, 0x0a, 0x07,
+	0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
+	0x63, 0x64, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x2e, 0x43, 0x6f, 0x6d, 0x70,
+	0x61, 0x63, 0x74, 0x65, 0x64, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x07, 0x73, 0x6b, 0x69,
+	0x70, 0x70, 0x65, 0x64, 0x22, 0xa9, 0x01, 0x0a, 0x0b, 0x4f, 0x75, 0x74, 0x73, 0x74, 0x61, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73,
+	0x65, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e,
+	0x63, 0x65, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x73,
+	0x73, 0x75, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x69, 0x73, 0x73, 0x75, 0x65,
+	0x12, 0x16, 0x0a, 0x06, 0x6f, 0x70, 0x65, 0x6e, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x06, 0x6f, 0x70, 0x65, 0x6e, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x65, 0x74, 0x61,
+	0x69, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c,
+	0x22, 0xf7, 0x02, 0x0a, 0x0a, 0x51, 0x75, 0x65, 0x75, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64,
+	0x12, 0x36, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x1e, 0x2e, 0x63, 0x64, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x2e, 0x51,
+	0x75, 0x65, 0x75, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x2e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x65, 0x74, 0x61,
+	0x69, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c,
+	0x12, 0x21, 0x0a, 0x0c, 0x65, 0x6e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x65, 0x6e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x54,
+	0x69, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x6e, 0x6f, 0x6f, 0x7a, 0x65, 0x5f, 0x75, 0x6e,
+	0x74, 0x69, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x73, 0x6e, 0x6f, 0x6f, 0x7a,
+	0x65, 0x55, 0x6e, 0x74, 0x69, 0x6c, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x6e, 0x6f, 0x6f, 0x7a, 0x65,
+	0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73,
+	0x6e, 0x6f, 0x6f, 0x7a, 0x65, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x8c, 0x01, 0x0a, 0x06,
+	0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57,
+	0x4e, 0x10, 0x00, 0x12, 0x11, 0x0a, 0x0d, 0x4d, 0x49, 0x53, 0x53, 0x49, 0x4e, 0x47, 0x5f, 0x54,
+	0x52, 0x41, 0x43, 0x4b, 0x10, 0x01, 0x12, 0x17, 0x0a, 0x13, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x43,
+	0x4f, 0x55, 0x4e, 0x54, 0x5f, 0x4d, 0x49, 0x53, 0x4d, 0x41, 0x54, 0x43, 0x48, 0x10, 0x02, 0x12,
+	0x10, 0x0a, 0x0c, 0x4e, 0x45, 0x56, 0x45, 0x52, 0x5f, 0x4c, 0x49, 0x4e, 0x4b, 0x45, 0x44, 0x10,
+	0x03, 0x12, 0x0a, 0x0a, 0x06, 0x4d, 0x41, 0x4e, 0x55, 0x41, 0x4c, 0x10, 0x04, 0x12, 0x12, 0x0a,
+	0x0e, 0x43, 0x4f, 0x52, 0x52, 0x55, 0x50, 0x54, 0x5f, 0x4f, 0x55, 0x54, 0x50, 0x55, 0x54, 0x10,
+	0x06, 0x22, 0x04, 0x08, 0x05, 0x10, 0x05, 0x2a, 0x11, 0x44, 0x55, 0x52, 0x41, 0x54, 0x49, 0x4f,
+	0x4e, 0x5f, 0x4d, 0x49, 0x53, 0x4d, 0x41, 0x54, 0x43, 0x48, 0x22, 0xd1, 0x01, 0x0a, 0x10, 0x47,
+	0x65, 0x74, 0x52, 0x69, 0x70, 0x70, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x12, 0x0a, 0x04, 0x64, 0x69, 0x73, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x64,
+	0x69, 0x73, 0x6b, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x5f, 0x6d,
+	0x70, 0x33, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6e,
+	0x67, 0x4d, 0x70, 0x33, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x5f,
+	0x66, 0x6c, 0x61, 0x63, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x6d, 0x69, 0x73, 0x73,
+	0x69, 0x6e, 0x67, 0x46, 0x6c, 0x61, 0x63, 0x12, 0x19, 0x0a, 0x08, 0x77, 0x61, 0x76, 0x5f, 0x6f,
+	0x6e, 0x6c, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x77, 0x61, 0x76, 0x4f, 0x6e,
+	0x6c, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x22, 0xb0,
+	0x02, 0x0a, 0x09, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x12, 0x0a, 0x04,
+	0x73, 0x69, 0x7a, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x6d, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x05, 0x6d, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x6d, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x64, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x61, 0x6d, 0x70, 0x6c,
+	0x65, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x73, 0x61,
+	0x6d, 0x70, 0x6c, 0x65, 0x52, 0x61, 0x74, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x62, 0x69, 0x74, 0x5f,
+	0x64, 0x65, 0x70, 0x74, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x62, 0x69, 0x74,
+	0x44, 0x65, 0x70, 0x74, 0x68, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x73, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x69, 0x74, 0x72, 0x61, 0x74, 0x65, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x07, 0x62, 0x69, 0x74, 0x72, 0x61, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x72, 0x72, 0x75, 0x70, 0x74, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x72, 0x72, 0x75, 0x70, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x63,
+	0x68, 0x65, 0x63, 0x6b, 0x65, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x63, 0x68,
+	0x65, 0x63, 0x6b, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65,
+	0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65,
+	0x73, 0x22, 0x91, 0x02, 0x0a, 0x05, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x12, 0x12, 0x0a, 0x04, 0x64,
+	0x69, 0x73, 0x6b, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x64, 0x69, 0x73, 0x6b, 0x12,
+	0x21, 0x0a, 0x0c, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62,
+	0x65, 0x72, 0x12, 0x19, 0x0a, 0x08, 0x77, 0x61, 0x76, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x77, 0x61, 0x76, 0x50, 0x61, 0x74, 0x68, 0x12, 0x19, 0x0a,
+	0x08, 0x6d, 0x70, 0x33, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x6d, 0x70, 0x33, 0x50, 0x61, 0x74, 0x68, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x6c, 0x61, 0x63,
+	0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x6c, 0x61,
+	0x63, 0x50, 0x61, 0x74, 0x68, 0x12, 0x28, 0x0a, 0x03, 0x77, 0x61, 0x76, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x16, 0x2e, 0x63, 0x64, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72,
+	0x2e, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x03, 0x77, 0x61, 0x76, 0x12,
+	0x28, 0x0a, 0x03, 0x6d, 0x70, 0x33, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x63,
+	0x64, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x2e, 0x41, 0x75, 0x64, 0x69, 0x6f,
+	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x03, 0x6d, 0x70, 0x33, 0x12, 0x2a, 0x0a, 0x04, 0x66, 0x6c, 0x61,
+	0x63, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x63, 0x64, 0x70, 0x72, 0x6f, 0x63,
+	0x65, 0x73, 0x73, 0x6f, 0x72, 0x2e, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x49, 0x6e, 0x66, 0x6f, 0x52,
+	0x04, 0x66, 0x6c, 0x61, 0x63, 0x22, 0xdd, 0x01, 0x0a, 0x03, 0x52, 0x69, 0x70, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a,
+	0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74,
+	0x68, 0x12, 0x2a, 0x0a, 0x06, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x12, 0x2e, 0x63, 0x64, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x2e,
+	0x54, 0x72, 0x61, 0x63, 0x6b, 0x52, 0x06, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x12, 0x1b, 0x0a,
+	0x09, 0x64, 0x69, 0x72, 0x5f, 0x6d, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x08, 0x64, 0x69, 0x72, 0x4d, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x75, 0x6e,
+	0x72, 0x65, 0x63, 0x6f, 0x67, 0x6e, 0x69, 0x73, 0x65, 0x64, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x0c, 0x75, 0x6e, 0x72, 0x65, 0x63, 0x6f, 0x67, 0x6e, 0x69, 0x73, 0x65, 0x64, 0x12, 0x1d,
+	0x0a, 0x0a, 0x73, 0x63, 0x61, 0x6e, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x73, 0x63, 0x61, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x12, 0x0a,
+	0x04, 0x73, 0x6c, 0x75, 0x67, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x6c, 0x75,
+	0x67, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x69, 0x73, 0x6b, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x04, 0x64, 0x69, 0x73, 0x6b, 0x22, 0x4f, 0x0a, 0x08, 0x52, 0x69, 0x70, 0x49, 0x6e, 0x64, 0x65,
+	0x78, 0x12, 0x24, 0x0a, 0x04, 0x72, 0x69, 0x70, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x10, 0x2e, 0x63, 0x64, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x2e, 0x52, 0x69,
+	0x70, 0x52, 0x04, 0x72, 0x69, 0x70, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x75, 0x69, 0x6c, 0x64,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x62, 0x75, 0x69,
+	0x6c, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x65, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x52, 0x69, 0x70,
+	0x70, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x06, 0x72,
+	0x69, 0x70, 0x70, 0x65, 0x64, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x63, 0x64,
+	0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x2e, 0x52, 0x69, 0x70, 0x52, 0x06, 0x72,
+	0x69, 0x70, 0x70, 0x65, 0x64, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61,
+	0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
+	0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x4e, 0x0a,
+	0x11, 0x47, 0x65, 0x74, 0x4d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x61, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x03, 0x6d, 0x61, 0x78, 0x12, 0x27, 0x0a, 0x0f, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f,
+	0x73, 0x6e, 0x6f, 0x6f, 0x7a, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x69,
+	0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x53, 0x6e, 0x6f, 0x6f, 0x7a, 0x65, 0x64, 0x22, 0x86, 0x01,
+	0x0a, 0x0d, 0x4d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12,
+	0x30, 0x0a, 0x06, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x18, 0x2e, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x06, 0x72, 0x65, 0x63, 0x6f, 0x72,
+	0x64, 0x12, 0x2d, 0x0a, 0x05, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x17, 0x2e, 0x63, 0x64, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x2e, 0x51,
+	0x75, 0x65, 0x75, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x65, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x7a, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x4d, 0x69, 0x73,
+	0x73, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x07,
+	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e,
+	0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x2e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x07, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67,
+	0x12, 0x30, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x75, 0x65, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x63, 0x64, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x2e, 0x4d, 0x69,
+	0x73, 0x73, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x05, 0x71, 0x75, 0x65,
+	0x75, 0x65, 0x22, 0xdf, 0x01, 0x0a, 0x0c, 0x46, 0x6f, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x37, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x23, 0x2e, 0x63, 0x64, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x2e,
+	0x46, 0x6f, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x46, 0x6f, 0x72,
+	0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x22, 0x85, 0x01, 0x0a,
+	0x09, 0x46, 0x6f, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x4e,
+	0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x52, 0x45, 0x43, 0x52, 0x45,
+	0x41, 0x54, 0x45, 0x5f, 0x4c, 0x49, 0x4e, 0x4b, 0x53, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x52,
+	0x45, 0x43, 0x4f, 0x4e, 0x56, 0x45, 0x52, 0x54, 0x5f, 0x4d, 0x50, 0x33, 0x10, 0x02, 0x12, 0x12,
+	0x0a, 0x0e, 0x52, 0x45, 0x43, 0x4f, 0x4e, 0x56, 0x45, 0x52, 0x54, 0x5f, 0x46, 0x4c, 0x41, 0x43,
+	0x10, 0x03, 0x12, 0x0e, 0x0a, 0x0a, 0x52, 0x45, 0x54, 0x41, 0x47, 0x5f, 0x4f, 0x4e, 0x4c, 0x59,
+	0x10, 0x04, 0x12, 0x0f, 0x0a, 0x0b, 0x56, 0x45, 0x52, 0x49, 0x46, 0x59, 0x5f, 0x4f, 0x4e, 0x4c,
+	0x59, 0x10, 0x05, 0x12, 0x0f, 0x0a, 0x0b, 0x52, 0x45, 0x53, 0x43, 0x41, 0x4e, 0x5f, 0x44, 0x49,
+	0x53, 0x4b, 0x10, 0x06, 0x22, 0x0f, 0x0a, 0x0d, 0x46, 0x6f, 0x72, 0x63, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x17, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x4f, 0x75, 0x74, 0x73,
+	0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x5e,
+	0x0a, 0x16, 0x47, 0x65, 0x74, 0x4f, 0x75, 0x74, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x64, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x05, 0x52, 0x03, 0x69, 0x64, 0x73, 0x12, 0x32, 0x0a, 0x07, 0x72, 0x65,
+	0x63, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x63, 0x64,
+	0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x2e, 0x4f, 0x75, 0x74, 0x73, 0x74, 0x61,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x22, 0xf6,
+	0x02, 0x0a, 0x08, 0x52, 0x69, 0x70, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x33, 0x0a, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x63, 0x64, 0x70, 0x72,
+	0x6f, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x2e, 0x52, 0x69, 0x70, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49,
+	0x64, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x69, 0x73, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x04, 0x64, 0x69, 0x73, 0x6b, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x12, 0x12, 0x0a, 0x04, 0x70,
+	0x61, 0x74, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12,
+	0x14, 0x0a, 0x05, 0x69, 0x73, 0x73, 0x75, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05,
+	0x69, 0x73, 0x73, 0x75, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x22, 0x91, 0x01, 0x0a, 0x09, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x0d,
+	0x0a, 0x09, 0x52, 0x49, 0x50, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x01, 0x12, 0x0e, 0x0a,
+	0x0a, 0x4d, 0x50, 0x33, 0x5f, 0x51, 0x55, 0x45, 0x55, 0x45, 0x44, 0x10, 0x02, 0x12, 0x0f, 0x0a,
+	0x0b, 0x46, 0x4c, 0x41, 0x43, 0x5f, 0x51, 0x55, 0x45, 0x55, 0x45, 0x44, 0x10, 0x03, 0x12, 0x10,
+	0x0a, 0x0c, 0x54, 0x52, 0x41, 0x43, 0x4b, 0x5f, 0x4c, 0x49, 0x4e, 0x4b, 0x45, 0x44, 0x10, 0x04,
+	0x12, 0x10, 0x0a, 0x0c, 0x49, 0x53, 0x53, 0x55, 0x45, 0x5f, 0x4f, 0x50, 0x45, 0x4e, 0x45, 0x44,
+	0x10, 0x05, 0x12, 0x10, 0x0a, 0x0c, 0x49, 0x53, 0x53, 0x55, 0x45, 0x5f, 0x43, 0x4c, 0x4f, 0x53,
+	0x45, 0x44, 0x10, 0x06, 0x12, 0x11, 0x0a, 0x0d, 0x43, 0x4f, 0x52, 0x52, 0x55, 0x50, 0x54, 0x5f,
+	0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x07, 0x22, 0x59, 0x0a, 0x10, 0x57, 0x61, 0x74, 0x63, 0x68,
+	0x52, 0x69, 0x70, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x35, 0x0a, 0x05, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x63, 0x64, 0x70,
+	0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x2e, 0x52, 0x69, 0x70, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x05, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x22, 0x36, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x52, 0x69, 0x70, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x73,
+	0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a,
+	0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x22, 0x59, 0x0a, 0x0d, 0x45, 0x78,
+	0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x12, 0x1a, 0x0a, 0x08, 0x70,
+	0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70,
+	0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x16, 0x0a,
+	0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66,
+	0x6f, 0x72, 0x6d, 0x61, 0x74, 0x22, 0x56, 0x0a, 0x0c, 0x45, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65,
+	0x64, 0x44, 0x69, 0x73, 0x6b, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x69, 0x73, 0x6b, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x69, 0x73, 0x6b, 0x12, 0x32, 0x0a, 0x06, 0x74, 0x72, 0x61,
+	0x63, 0x6b, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x63, 0x64, 0x70, 0x72,
+	0x6f, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x2e, 0x45, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64,
+	0x54, 0x72, 0x61, 0x63, 0x6b, 0x52, 0x06, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x22, 0xd9, 0x03,
+	0x0a, 0x14, 0x47, 0x65, 0x74, 0x52, 0x69, 0x70, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x67, 0x6f, 0x61, 0x6c, 0x5f, 0x66,
+	0x6f, 0x6c, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x67, 0x6f, 0x61,
+	0x6c, 0x46, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x6f, 0x6c, 0x64, 0x65,
+	0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x66, 0x6f, 0x6c, 0x64,
+	0x65, 0x72, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x73, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x73, 0x12, 0x2f,
+	0x0a, 0x05, 0x64, 0x69, 0x73, 0x6b, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x63, 0x64, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x2e, 0x45, 0x78, 0x70, 0x65,
+	0x63, 0x74, 0x65, 0x64, 0x44, 0x69, 0x73, 0x6b, 0x52, 0x05, 0x64, 0x69, 0x73, 0x6b, 0x73, 0x12,
+	0x24, 0x0a, 0x04, 0x72, 0x69, 0x70, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e,
+	0x63, 0x64, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x2e, 0x52, 0x69, 0x70, 0x52,
+	0x04, 0x72, 0x69, 0x70, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x69, 0x70, 0x5f, 0x66, 0x69, 0x6c,
+	0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x72, 0x69, 0x70, 0x46, 0x69, 0x6c,
+	0x65, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x70, 0x33, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x18,
+	0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x70, 0x33, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x12,
+	0x1d, 0x0a, 0x0a, 0x66, 0x6c, 0x61, 0x63, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x08, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x09, 0x66, 0x6c, 0x61, 0x63, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x14,
+	0x0a, 0x05, 0x69, 0x73, 0x73, 0x75, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x69,
+	0x73, 0x73, 0x75, 0x65, 0x12, 0x2a, 0x0a, 0x11, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x70, 0x72, 0x6f,
+	0x63, 0x65, 0x73, 0x73, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0f, 0x6c, 0x61, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x54, 0x69, 0x6d, 0x65,
+	0x12, 0x22, 0x0a, 0x0d, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x72, 0x69, 0x70, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x6c, 0x61, 0x73, 0x74, 0x52, 0x69, 0x70,
+	0x54, 0x69, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x69, 0x6e, 0x5f, 0x71, 0x75, 0x65, 0x75, 0x65,
+	0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x69, 0x6e, 0x51, 0x75, 0x65, 0x75, 0x65, 0x12,
+	0x38, 0x0a, 0x0b, 0x71, 0x75, 0x65, 0x75, 0x65, 0x5f, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x0d,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63, 0x64, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73,
+	0x6f, 0x72, 0x2e, 0x51, 0x75, 0x65, 0x75, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x71,
+	0x75, 0x65, 0x75, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x22, 0x4c, 0x0a, 0x08, 0x4c, 0x69, 0x6e,
+	0x6b, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x69, 0x72,
+	0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x66, 0x69, 0x72, 0x65, 0x64, 0x12,
+	0x16, 0x0a, 0x06, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x22, 0x4c, 0x0a, 0x13, 0x45, 0x78, 0x70, 0x6c, 0x61,
+	0x69, 0x6e, 0x4c, 0x69, 0x6e, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f,
+	0x0a, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0a, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12,
+	0x14, 0x0a, 0x05, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05,
+	0x66, 0x6f, 0x72, 0x63, 0x65, 0x22, 0x89, 0x01, 0x0a, 0x14, 0x45, 0x78, 0x70, 0x6c, 0x61, 0x69,
+	0x6e, 0x4c, 0x69, 0x6e, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b,
+	0x0a, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e,
+	0x63, 0x64, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x2e, 0x4c, 0x69, 0x6e, 0x6b,
+	0x52, 0x75, 0x6c, 0x65, 0x52, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x66,
+	0x69, 0x72, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x66, 0x69, 0x72, 0x65,
+	0x64, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x05, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x22, 0xcc, 0x01, 0x0a, 0x10, 0x42, 0x75, 0x6c, 0x6b, 0x46, 0x6f, 0x72, 0x63, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x37, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x23, 0x2e, 0x63, 0x64, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73,
+	0x6f, 0x72, 0x2e, 0x46, 0x6f, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e,
+	0x46, 0x6f, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12,
+	0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x09, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x49, 0x64, 0x12, 0x1b,
+	0x0a, 0x09, 0x66, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x08, 0x66, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x69,
+	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x05, 0x52, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x73, 0x12, 0x20,
+	0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79,
+	0x22, 0x44, 0x0a, 0x0b, 0x46, 0x6f, 0x72, 0x63, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12,
+	0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64,
+	0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x47, 0x0a, 0x11, 0x42, 0x75, 0x6c, 0x6b, 0x46, 0x6f,
+	0x72, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x07, 0x72,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x63,
+	0x64, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x2e, 0x46, 0x6f, 0x72, 0x63, 0x65,
+	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x22,
+	0x49, 0x0a, 0x0e, 0x45, 0x6e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
+	0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x40, 0x0a, 0x0f, 0x45, 0x6e,
+	0x71, 0x75, 0x65, 0x75, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a,
+	0x05, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63,
+	0x64, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x2e, 0x51, 0x75, 0x65, 0x75, 0x65,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x22, 0x49, 0x0a, 0x0e,
+	0x44, 0x65, 0x71, 0x75, 0x65, 0x75, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f,
+	0x0a, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0a, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12,
+	0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x11, 0x0a, 0x0f, 0x44, 0x65, 0x71, 0x75, 0x65,
+	0x75, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x5e, 0x0a, 0x0d, 0x53, 0x6e,
+	0x6f, 0x6f, 0x7a, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x69,
+	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0a, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05,
+	0x75, 0x6e, 0x74, 0x69, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x75, 0x6e, 0x74,
+	0x69, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x3f, 0x0a, 0x0e, 0x53, 0x6e,
+	0x6f, 0x6f, 0x7a, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x05,
+	0x65, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63, 0x64,
+	0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x2e, 0x51, 0x75, 0x65, 0x75, 0x65, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x22, 0x34, 0x0a, 0x11, 0x47,
+	0x65, 0x74, 0x54, 0x61, 0x67, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49,
+	0x64, 0x22, 0x3f, 0x0a, 0x0d, 0x46, 0x69, 0x6c, 0x65, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d,
+	0x61, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61,
+	0x6e, 0x64, 0x22, 0xd9, 0x02, 0x0a, 0x09, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x50, 0x6c, 0x61, 0x6e,
+	0x12, 0x12, 0x0a, 0x04, 0x64, 0x69, 0x73, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x64, 0x69, 0x73, 0x6b, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x12, 0x14,
+	0x0a, 0x05, 0x61, 0x6c, 0x62, 0x75, 0x6d, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61,
+	0x6c, 0x62, 0x75, 0x6d, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x69, 0x73, 0x63, 0x5f, 0x6e, 0x75, 0x6d,
+	0x62, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x64, 0x69, 0x73, 0x63, 0x4e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x23, 0x0a,
+	0x0d, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x65, 0x78, 0x69, 0x73, 0x74, 0x73, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x45, 0x78, 0x69, 0x73,
+	0x74, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x70, 0x33, 0x5f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x70, 0x33, 0x54, 0x61, 0x72, 0x67, 0x65,
+	0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x6c, 0x61, 0x63, 0x5f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x66, 0x6c, 0x61, 0x63, 0x54, 0x61, 0x72, 0x67,
+	0x65, 0x74, 0x12, 0x3a, 0x0a, 0x0a, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x63, 0x64, 0x70, 0x72, 0x6f, 0x63, 0x65,
+	0x73, 0x73, 0x6f, 0x72, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x0a, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x44,
+	0x0a, 0x12, 0x47, 0x65, 0x74, 0x54, 0x61, 0x67, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x06, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x63, 0x64, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73,
+	0x6f, 0x72, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x06, 0x74, 0x72,
+	0x61, 0x63, 0x6b, 0x73, 0x22, 0x2f, 0x0a, 0x14, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07,
+	0x64, 0x72, 0x79, 0x5f, 0x72, 0x75, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x64,
+	0x72, 0x79, 0x52, 0x75, 0x6e, 0x22, 0x4e, 0x0a, 0x15, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35,
+	0x0a, 0x06, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d,
+	0x2e, 0x63, 0x64, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x2e, 0x43, 0x6f, 0x6d,
+	0x70, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x06, 0x72,
+	0x65, 0x70, 0x6f, 0x72, 0x74, 0x22, 0x92, 0x03, 0x0a, 0x0c, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72,
+	0x79, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x32, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x63, 0x64, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73,
+	0x6f, 0x72, 0x2e, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x2e,
+	0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x73, 0x74,
+	0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x69,
+	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x65, 0x74,
+	0x61, 0x69, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x65, 0x74, 0x61, 0x69,
+	0x6c, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0xe0, 0x01, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x0b, 0x0a, 0x07, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x0d, 0x0a,
+	0x09, 0x52, 0x49, 0x50, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x01, 0x12, 0x0e, 0x0a, 0x0a,
+	0x4d, 0x50, 0x33, 0x5f, 0x51, 0x55, 0x45, 0x55, 0x45, 0x44, 0x10, 0x02, 0x12, 0x0f, 0x0a, 0x0b,
+	0x46, 0x4c, 0x41, 0x43, 0x5f, 0x51, 0x55, 0x45, 0x55, 0x45, 0x44, 0x10, 0x03, 0x12, 0x0f, 0x0a,
+	0x0b, 0x4c, 0x49, 0x4e, 0x4b, 0x53, 0x5f, 0x42, 0x55, 0x49, 0x4c, 0x54, 0x10, 0x04, 0x12, 0x11,
+	0x0a, 0x0d, 0x56, 0x45, 0x52, 0x49, 0x46, 0x59, 0x5f, 0x50, 0x41, 0x53, 0x53, 0x45, 0x44, 0x10,
+	0x05, 0x12, 0x11, 0x0a, 0x0d, 0x56, 0x45, 0x52, 0x49, 0x46, 0x59, 0x5f, 0x46, 0x41, 0x49, 0x4c,
+	0x45, 0x44, 0x10, 0x06, 0x12, 0x10, 0x0a, 0x0c, 0x49, 0x53, 0x53, 0x55, 0x45, 0x5f, 0x4f, 0x50,
+	0x45, 0x4e, 0x45, 0x44, 0x10, 0x07, 0x12, 0x10, 0x0a, 0x0c, 0x49, 0x53, 0x53, 0x55, 0x45, 0x5f,
+	0x43, 0x4c, 0x4f, 0x53, 0x45, 0x44, 0x10, 0x08, 0x12, 0x0a, 0x0a, 0x06, 0x46, 0x4f, 0x52, 0x43,
+	0x45, 0x44, 0x10, 0x09, 0x12, 0x0a, 0x0a, 0x06, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x0a,
+	0x12, 0x11, 0x0a, 0x0d, 0x43, 0x4f, 0x52, 0x52, 0x55, 0x50, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e,
+	0x44, 0x10, 0x0b, 0x12, 0x15, 0x0a, 0x11, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x53, 0x55, 0x4d, 0x5f,
+	0x4d, 0x49, 0x53, 0x4d, 0x41, 0x54, 0x43, 0x48, 0x10, 0x0c, 0x22, 0x44, 0x0a, 0x0d, 0x52, 0x65,
+	0x63, 0x6f, 0x72, 0x64, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x33, 0x0a, 0x07, 0x65,
+	0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63,
+	0x64, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x2e, 0x48, 0x69, 0x73, 0x74, 0x6f,
+	0x72, 0x79, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73,
+	0x22, 0x9e, 0x01, 0x0a, 0x07, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x3b, 0x0a, 0x07,
+	0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e,
+	0x63, 0x64, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x2e, 0x48, 0x69, 0x73, 0x74,
+	0x6f, 0x72, 0x79, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x52, 0x07, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x1a, 0x56, 0x0a, 0x0c, 0x52, 0x65, 0x63,
+	0x6f, 0x72, 0x64, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x30, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x63, 0x64, 0x70,
+	0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x48,
+	0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
+	0x01, 0x22, 0x35, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x61, 0x78, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x03, 0x6d, 0x61, 0x78, 0x22, 0x49, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x48,
+	0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33,
+	0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x63, 0x64, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x2e, 0x48, 0x69,
+	0x73, 0x74, 0x6f, 0x72, 0x79, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72,
+	0x69, 0x65, 0x73, 0x22, 0x15, 0x0a, 0x13, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x43, 0x0a, 0x14, 0x45, 0x78,
+	0x70, 0x6f, 0x72, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x2b, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x13, 0x2e, 0x63, 0x64, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72,
+	0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22,
+	0x5b, 0x0a, 0x13, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2b, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x63, 0x64, 0x70, 0x72, 0x6f, 0x63, 0x65,
+	0x73, 0x73, 0x6f, 0x72, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x06, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x72, 0x79, 0x5f, 0x72, 0x75, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x64, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x22, 0x2a, 0x0a, 0x14,
+	0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x69, 0x66, 0x66, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x04, 0x64, 0x69, 0x66, 0x66, 0x22, 0xe3, 0x01, 0x0a, 0x0a, 0x52, 0x69, 0x70,
+	0x50, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x12, 0x30, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x63, 0x64, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73,
+	0x73, 0x6f, 0x72, 0x2e, 0x52, 0x69, 0x70, 0x50, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x2e, 0x4b,
+	0x69, 0x6e, 0x64, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x15, 0x0a, 0x06, 0x72, 0x69, 0x70,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x72, 0x69, 0x70, 0x49, 0x64,
+	0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x49, 0x64, 0x12,
+	0x14, 0x0a, 0x05, 0x70, 0x61, 0x74, 0x68, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05,
+	0x70, 0x61, 0x74, 0x68, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x22, 0x3f, 0x0a,
+	0x04, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e,
+	0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x4f, 0x52, 0x50, 0x48, 0x41, 0x4e, 0x10, 0x01, 0x12, 0x0f,
+	0x0a, 0x0b, 0x49, 0x4e, 0x53, 0x54, 0x41, 0x4e, 0x43, 0x45, 0x5f, 0x49, 0x44, 0x10, 0x02, 0x12,
+	0x0d, 0x0a, 0x09, 0x44, 0x55, 0x50, 0x4c, 0x49, 0x43, 0x41, 0x54, 0x45, 0x10, 0x03, 0x22, 0xa7,
+	0x01, 0x0a, 0x08, 0x52, 0x69, 0x70, 0x41, 0x75, 0x64, 0x69, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x72,
+	0x75, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x72,
+	0x75, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x65,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x64,
+	0x12, 0x33, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x73, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63, 0x64, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72,
+	0x2e, 0x52, 0x69, 0x70, 0x50, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x52, 0x08, 0x70, 0x72, 0x6f,
+	0x62, 0x6c, 0x65, 0x6d, 0x73, 0x12, 0x31, 0x0a, 0x07, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64,
+	0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63, 0x64, 0x70, 0x72, 0x6f, 0x63, 0x65,
+	0x73, 0x73, 0x6f, 0x72, 0x2e, 0x52, 0x69, 0x70, 0x50, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x52,
+	0x07, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x22, 0x12, 0x0a, 0x10, 0x41, 0x75, 0x64, 0x69,
+	0x74, 0x52, 0x69, 0x70, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x40, 0x0a, 0x11,
+	0x41, 0x75, 0x64, 0x69, 0x74, 0x52, 0x69, 0x70, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x2b, 0x0a, 0x05, 0x61, 0x75, 0x64, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x15, 0x2e, 0x63, 0x64, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x2e, 0x52,
+	0x69, 0x70, 0x41, 0x75, 0x64, 0x69, 0x74, 0x52, 0x05, 0x61, 0x75, 0x64, 0x69, 0x74, 0x22, 0xea,
+	0x01, 0x0a, 0x0c, 0x46, 0x69, 0x6c, 0x65, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x75, 0x6d, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70,
+	0x61, 0x74, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x74, 0x69, 0x6d, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6d, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x16, 0x0a,
+	0x06, 0x73, 0x68, 0x61, 0x32, 0x35, 0x36, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73,
+	0x68, 0x61, 0x32, 0x35, 0x36, 0x12, 0x19, 0x0a, 0x08, 0x66, 0x6c, 0x61, 0x63, 0x5f, 0x6d, 0x64,
+	0x35, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x66, 0x6c, 0x61, 0x63, 0x4d, 0x64, 0x35,
+	0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x08, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x65, 0x64, 0x12, 0x23, 0x0a, 0x0d,
+	0x6c, 0x61, 0x73, 0x74, 0x5f, 0x73, 0x63, 0x72, 0x75, 0x62, 0x62, 0x65, 0x64, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0c, 0x6c, 0x61, 0x73, 0x74, 0x53, 0x63, 0x72, 0x75, 0x62, 0x62, 0x65,
+	0x64, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x22, 0x97, 0x01, 0x0a, 0x08,
+	0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x12, 0x36, 0x0a, 0x05, 0x66, 0x69, 0x6c, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x63, 0x64, 0x70, 0x72, 0x6f, 0x63,
+	0x65, 0x73, 0x73, 0x6f, 0x72, 0x2e, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x2e, 0x46,
+	0x69, 0x6c, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x66, 0x69, 0x6c, 0x65, 0x73,
+	0x1a, 0x53, 0x0a, 0x0a, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x2f, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x63, 0x64, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x2e, 0x46, 0x69,
+	0x6c, 0x65, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x75, 0x6d, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x85, 0x02, 0x0a, 0x0d, 0x53, 0x63, 0x72, 0x75, 0x62, 0x50,
+	0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08,
+	0x73, 0x63, 0x72, 0x75, 0x62, 0x62, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08,
+	0x73, 0x63, 0x72, 0x75, 0x62, 0x62, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x79, 0x74, 0x65,
+	0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x62, 0x79, 0x74, 0x65, 0x73, 0x12, 0x1a,
+	0x0a, 0x08, 0x70, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x08, 0x70, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x75,
+	0x72, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x66, 0x69, 0x6e,
+	0x69, 0x73, 0x68, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x6c, 0x61, 0x73,
+	0x74, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x12, 0x32, 0x0a, 0x15, 0x6c, 0x61, 0x73,
+	0x74, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e,
+	0x64, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x13, 0x6c, 0x61, 0x73, 0x74, 0x44, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x22, 0x19, 0x0a,
+	0x17, 0x47, 0x65, 0x74, 0x53, 0x63, 0x72, 0x75, 0x62, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x89, 0x01, 0x0a, 0x18, 0x47, 0x65, 0x74,
+	0x53, 0x63, 0x72, 0x75, 0x62, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x63, 0x64, 0x70, 0x72, 0x6f, 0x63,
+	0x65, 0x73, 0x73, 0x6f, 0x72, 0x2e, 0x53, 0x63, 0x72, 0x75, 0x62, 0x50, 0x72, 0x6f, 0x67, 0x72,
+	0x65, 0x73, 0x73, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x35, 0x0a,
+	0x08, 0x70, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x63, 0x64, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x2e, 0x46, 0x69,
+	0x6c, 0x65, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x75, 0x6d, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x62,
+	0x6c, 0x65, 0x6d, 0x73, 0x32, 0x9a, 0x0b, 0x0a, 0x0b, 0x43, 0x44, 0x50, 0x72, 0x6f, 0x63, 0x65,
+	0x73, 0x73, 0x6f, 0x72, 0x12, 0x4a, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x52, 0x69, 0x70, 0x70, 0x65,
+	0x64, 0x12, 0x1d, 0x2e, 0x63, 0x64, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x2e,
+	0x47, 0x65, 0x74, 0x52, 0x69, 0x70, 0x70, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1e, 0x2e, 0x63, 0x64, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x2e, 0x47,
+	0x65, 0x74, 0x52, 0x69, 0x70, 0x70, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x4d, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x4d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x12, 0x1e,
+	0x2e, 0x63, 0x64, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x2e, 0x47, 0x65, 0x74,
+	0x4d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f,
+	0x2e, 0x63, 0x64, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x2e, 0x47, 0x65, 0x74,
+	0x4d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x3e, 0x0a, 0x05, 0x46, 0x6f, 0x72, 0x63, 0x65, 0x12, 0x19, 0x2e, 0x63, 0x64, 0x70, 0x72, 0x6f,
+	0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x2e, 0x46, 0x6f, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x63, 0x64, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x6f,
+	0x72, 0x2e, 0x46, 0x6f, 0x72, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x59, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x4f, 0x75, 0x74, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e,
+	0x67, 0x12, 0x22, 0x2e, 0x63, 0x64, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x2e,
+	0x47, 0x65, 0x74, 0x4f, 0x75, 0x74, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x63, 0x64, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73,
+	0x73, 0x6f, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x4f, 0x75, 0x74, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x43, 0x0a, 0x09, 0x57, 0x61,
+	0x74, 0x63, 0x68, 0x52, 0x69, 0x70, 0x73, 0x12, 0x1d, 0x2e, 0x63, 0x64, 0x70, 0x72, 0x6f, 0x63,
+	0x65, 0x73, 0x73, 0x6f, 0x72, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x52, 0x69, 0x70, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x63, 0x64, 0x70, 0x72, 0x6f, 0x63, 0x65,
+	0x73, 0x73, 0x6f, 0x72, 0x2e, 0x52, 0x69, 0x70, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x30, 0x01, 0x12,
+	0x53, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x52, 0x69, 0x70, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x20, 0x2e, 0x63, 0x64, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x2e, 0x47, 0x65,
+	0x74, 0x52, 0x69, 0x70, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x21, 0x2e, 0x63, 0x64, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x2e,
+	0x47, 0x65, 0x74, 0x52, 0x69, 0x70, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a, 0x0c, 0x45, 0x78, 0x70, 0x6c, 0x61, 0x69, 0x6e, 0x4c,
+	0x69, 0x6e, 0x6b, 0x73, 0x12, 0x20, 0x2e, 0x63, 0x64, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73,
+	0x6f, 0x72, 0x2e, 0x45, 0x78, 0x70, 0x6c, 0x61, 0x69, 0x6e, 0x4c, 0x69, 0x6e, 0x6b, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x63, 0x64, 0x70, 0x72, 0x6f, 0x63, 0x65,
+	0x73, 0x73, 0x6f, 0x72, 0x2e, 0x45, 0x78, 0x70, 0x6c, 0x61, 0x69, 0x6e, 0x4c, 0x69, 0x6e, 0x6b,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a, 0x09, 0x42, 0x75, 0x6c,
+	0x6b, 0x46, 0x6f, 0x72, 0x63, 0x65, 0x12, 0x1d, 0x2e, 0x63, 0x64, 0x70, 0x72, 0x6f, 0x63, 0x65,
+	0x73, 0x73, 0x6f, 0x72, 0x2e, 0x42, 0x75, 0x6c, 0x6b, 0x46, 0x6f, 0x72, 0x63, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x63, 0x64, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73,
+	0x73, 0x6f, 0x72, 0x2e, 0x42, 0x75, 0x6c, 0x6b, 0x46, 0x6f, 0x72, 0x63, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x07, 0x45, 0x6e, 0x71, 0x75, 0x65, 0x75, 0x65,
+	0x12, 0x1b, 0x2e, 0x63, 0x64, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x2e, 0x45,
+	0x6e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e,
+	0x63, 0x64, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x2e, 0x45, 0x6e, 0x71, 0x75,
+	0x65, 0x75, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x07, 0x44,
+	0x65, 0x71, 0x75, 0x65, 0x75, 0x65, 0x12, 0x1b, 0x2e, 0x63, 0x64, 0x70, 0x72, 0x6f, 0x63, 0x65,
+	0x73, 0x73, 0x6f, 0x72, 0x2e, 0x44, 0x65, 0x71, 0x75, 0x65, 0x75, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x63, 0x64, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x6f,
+	0x72, 0x2e, 0x44, 0x65, 0x71, 0x75, 0x65, 0x75, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x41, 0x0a, 0x06, 0x53, 0x6e, 0x6f, 0x6f, 0x7a, 0x65, 0x12, 0x1a, 0x2e, 0x63, 0x64,
+	0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x2e, 0x53, 0x6e, 0x6f, 0x6f, 0x7a, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x63, 0x64, 0x70, 0x72, 0x6f, 0x63,
+	0x65, 0x73, 0x73, 0x6f, 0x72, 0x2e, 0x53, 0x6e, 0x6f, 0x6f, 0x7a, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4d, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x54, 0x61, 0x67, 0x50, 0x6c,
+	0x61, 0x6e, 0x12, 0x1e, 0x2e, 0x63, 0x64, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72,
+	0x2e, 0x47, 0x65, 0x74, 0x54, 0x61, 0x67, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x63, 0x64, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72,
+	0x2e, 0x47, 0x65, 0x74, 0x54, 0x61, 0x67, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x56, 0x0a, 0x0d, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x12, 0x21, 0x2e, 0x63, 0x64, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73,
+	0x6f, 0x72, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x63, 0x64, 0x70, 0x72, 0x6f, 0x63,
+	0x65, 0x73, 0x73, 0x6f, 0x72, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4d, 0x0a, 0x0a, 0x47,
+	0x65, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x1e, 0x2e, 0x63, 0x64, 0x70, 0x72,
+	0x6f, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f,
+	0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x63, 0x64, 0x70, 0x72,
+	0x6f, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f,
+	0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a, 0x0c, 0x45, 0x78,
+	0x70, 0x6f, 0x72, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x20, 0x2e, 0x63, 0x64, 0x70,
+	0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x63,
+	0x64, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72,
+	0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x53, 0x0a, 0x0c, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12,
+	0x20, 0x2e, 0x63, 0x64, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x2e, 0x49, 0x6d,
+	0x70, 0x6f, 0x72, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x21, 0x2e, 0x63, 0x64, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x2e,
+	0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a, 0x09, 0x41, 0x75, 0x64, 0x69, 0x74, 0x52, 0x69, 0x70,
+	0x73, 0x12, 0x1d, 0x2e, 0x63, 0x64, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x2e,
+	0x41, 0x75, 0x64, 0x69, 0x74, 0x52, 0x69, 0x70, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1e, 0x2e, 0x63, 0x64, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x2e, 0x41,
+	0x75, 0x64, 0x69, 0x74, 0x52, 0x69, 0x70, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x5f, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x53, 0x63, 0x72, 0x75, 0x62, 0x50, 0x72, 0x6f, 0x67,
+	0x72, 0x65, 0x73, 0x73, 0x12, 0x24, 0x2e, 0x63, 0x64, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73,
+	0x6f, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x63, 0x72, 0x75, 0x62, 0x50, 0x72, 0x6f, 0x67, 0x72,
+	0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x63, 0x64, 0x70,
+	0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x63, 0x72, 0x75,
+	0x62, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x42, 0x2b, 0x5a, 0x29, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x62, 0x72, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2f, 0x63, 0x64, 0x70,
+	0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_cdprocessor_proto_rawDescOnce sync.Once
+	file_cdprocessor_proto_rawDescData = file_cdprocessor_proto_rawDesc
+)
+
+func file_cdprocessor_proto_rawDescGZIP() []byte {
+	file_cdprocessor_proto_rawDescOnce.Do(func() {
+		file_cdprocessor_proto_rawDescData = protoimpl.X.CompressGZIP(file_cdprocessor_proto_rawDescData)
+	})
+	return file_cdprocessor_proto_rawDescData
+}
+
+var file_cdprocessor_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
+var file_cdprocessor_proto_msgTypes = make([]protoimpl.MessageInfo, 70)
+var file_cdprocessor_proto_goTypes = []interface{}{
+	(QueueEntry_Reason)(0),           // 0: cdprocessor.QueueEntry.Reason
+	(ForceRequest_ForceType)(0),      // 1: cdprocessor.ForceRequest.ForceType
+	(RipEvent_EventType)(0),          // 2: cdprocessor.RipEvent.EventType
+	(HistoryEntry_Type)(0),           // 3: cdprocessor.HistoryEntry.Type
+	(RipProblem_Kind)(0),             // 4: cdprocessor.RipProblem.Kind
+	(*Config)(nil),                   // 5: cdprocessor.Config
+	(*QueueSuppression)(nil),         // 6: cdprocessor.QueueSuppression
+	(*CompactedRecord)(nil),          // 7: cdprocessor.CompactedRecord
+	(*CompactionReport)(nil),         // 8: cdprocessor.CompactionReport
+	(*Outstanding)(nil),              // 9: cdprocessor.Outstanding
+	(*QueueEntry)(nil),               // 10: cdprocessor.QueueEntry
+	(*GetRippedRequest)(nil),         // 11: cdprocessor.GetRippedRequest
+	(*AudioInfo)(nil),                // 12: cdprocessor.AudioInfo
+	(*Track)(nil),                    // 13: cdprocessor.Track
+	(*Rip)(nil),                      // 14: cdprocessor.Rip
+	(*RipIndex)(nil),                 // 15: cdprocessor.RipIndex
+	(*GetRippedResponse)(nil),        // 16: cdprocessor.GetRippedResponse
+	(*GetMissingRequest)(nil),        // 17: cdprocessor.GetMissingRequest
+	(*MissingRecord)(nil),            // 18: cdprocessor.MissingRecord
+	(*GetMissingResponse)(nil),       // 19: cdprocessor.GetMissingResponse
+	(*ForceRequest)(nil),             // 20: cdprocessor.ForceRequest
+	(*ForceResponse)(nil),            // 21: cdprocessor.ForceResponse
+	(*GetOutstandingRequest)(nil),    // 22: cdprocessor.GetOutstandingRequest
+	(*GetOutstandingResponse)(nil),   // 23: cdprocessor.GetOutstandingResponse
+	(*RipEvent)(nil),                 // 24: cdprocessor.RipEvent
+	(*WatchRipsRequest)(nil),         // 25: cdprocessor.WatchRipsRequest
+	(*GetRipStatusRequest)(nil),      // 26: cdprocessor.GetRipStatusRequest
+	(*ExpectedTrack)(nil),            // 27: cdprocessor.ExpectedTrack
+	(*ExpectedDisk)(nil),             // 28: cdprocessor.ExpectedDisk
+	(*GetRipStatusResponse)(nil),     // 29: cdprocessor.GetRipStatusResponse
+	(*LinkRule)(nil),                 // 30: cdprocessor.LinkRule
+	(*ExplainLinksRequest)(nil),      // 31: cdprocessor.ExplainLinksRequest
+	(*ExplainLinksResponse)(nil),     // 32: cdprocessor.ExplainLinksResponse
+	(*BulkForceRequest)(nil),         // 33: cdprocessor.BulkForceRequest
+	(*ForceResult)(nil),              // 34: cdprocessor.ForceResult
+	(*BulkForceResponse)(nil),        // 35: cdprocessor.BulkForceResponse
+	(*EnqueueRequest)(nil),           // 36: cdprocessor.EnqueueRequest
+	(*EnqueueResponse)(nil),          // 37: cdprocessor.EnqueueResponse
+	(*DequeueRequest)(nil),           // 38: cdprocessor.DequeueRequest
+	(*DequeueResponse)(nil),          // 39: cdprocessor.DequeueResponse
+	(*SnoozeRequest)(nil),            // 40: cdprocessor.SnoozeRequest
+	(*SnoozeResponse)(nil),           // 41: cdprocessor.SnoozeResponse
+	(*GetTagPlanRequest)(nil),        // 42: cdprocessor.GetTagPlanRequest
+	(*FileOperation)(nil),            // 43: cdprocessor.FileOperation
+	(*TrackPlan)(nil),                // 44: cdprocessor.TrackPlan
+	(*GetTagPlanResponse)(nil),       // 45: cdprocessor.GetTagPlanResponse
+	(*CompactConfigRequest)(nil),     // 46: cdprocessor.CompactConfigRequest
+	(*CompactConfigResponse)(nil),    // 47: cdprocessor.CompactConfigResponse
+	(*HistoryEntry)(nil),             // 48: cdprocessor.HistoryEntry
+	(*RecordHistory)(nil),            // 49: cdprocessor.RecordHistory
+	(*History)(nil),                  // 50: cdprocessor.History
+	(*GetHistoryRequest)(nil),        // 51: cdprocessor.GetHistoryRequest
+	(*GetHistoryResponse)(nil),       // 52: cdprocessor.GetHistoryResponse
+	(*ExportConfigRequest)(nil),      // 53: cdprocessor.ExportConfigRequest
+	(*ExportConfigResponse)(nil),     // 54: cdprocessor.ExportConfigResponse
+	(*ImportConfigRequest)(nil),      // 55: cdprocessor.ImportConfigRequest
+	(*ImportConfigResponse)(nil),     // 56: cdprocessor.ImportConfigResponse
+	(*RipProblem)(nil),               // 57: cdprocessor.RipProblem
+	(*RipAudit)(nil),                 // 58: cdprocessor.RipAudit
+	(*AuditRipsRequest)(nil),         // 59: cdprocessor.AuditRipsRequest
+	(*AuditRipsResponse)(nil),        // 60: cdprocessor.AuditRipsResponse
+	(*FileChecksum)(nil),             // 61: cdprocessor.FileChecksum
+	(*Manifest)(nil),                 // 62: cdprocessor.Manifest
+	(*ScrubProgress)(nil),            // 63: cdprocessor.ScrubProgress
+	(*GetScrubProgressRequest)(nil),  // 64: cdprocessor.GetScrubProgressRequest
+	(*GetScrubProgressResponse)(nil), // 65: cdprocessor.GetScrubProgressResponse
+	nil,                              // 66: cdprocessor.Config.LastProcessTimeEntry
+	nil,                              // 67: cdprocessor.Config.IssueMappingEntry
+	nil,                              // 68: cdprocessor.Config.LastRipTimeEntry
+	nil,                              // 69: cdprocessor.Config.GoalFolderEntry
+	nil,                              // 70: cdprocessor.Config.ToGoDetailEntry
+	nil,                              // 71: cdprocessor.Config.OutstandingEntry
+	nil,                              // 72: cdprocessor.Config.SuppressedEntry
+	nil,                              // 73: cdprocessor.History.RecordsEntry
+	nil,                              // 74: cdprocessor.Manifest.FilesEntry
+	(*proto.Record)(nil),             // 75: recordcollection.Record
+}
+var file_cdprocessor_proto_depIdxs = []int32{
+	66, // 0: cdprocessor.Config.last_process_time:type_name -> cdprocessor.Config.LastProcessTimeEntry
+	67, // 1: cdprocessor.Config.issue_mapping:type_name -> cdprocessor.Config.IssueMappingEntry
+	68, // 2: cdprocessor.Config.last_rip_time:type_name -> cdprocessor.Config.LastRipTimeEntry
+	69, // 3: cdprocessor.Config.goal_folder:type_name -> cdprocessor.Config.GoalFolderEntry
+	70, // 4: cdprocessor.Config.to_go_detail:type_name -> cdprocessor.Config.ToGoDetailEntry
+	71, // 5: cdprocessor.Config.outstanding:type_name -> cdprocessor.Config.OutstandingEntry
+	8,  // 6: cdprocessor.Config.last_compaction:type_name -> cdprocessor.CompactionReport
+	72, // 7: cdprocessor.Config.suppressed:type_name -> cdprocessor.Config.SuppressedEntry
+	7,  // 8: cdprocessor.CompactionReport.removed:type_name -> cdprocessor.CompactedRecord
+	7,  // 9: cdprocessor.CompactionReport.skipped:type_name -> cdprocessor.CompactedRecord
+	0,  // 10: cdprocessor.QueueEntry.reason:type_name -> cdprocessor.QueueEntry.Reason
+	12, // 11: cdprocessor.Track.wav:type_name -> cdprocessor.AudioInfo
+	12, // 12: cdprocessor.Track.mp3:type_name -> cdprocessor.AudioInfo
+	12, // 13: cdprocessor.Track.flac:type_name -> cdprocessor.AudioInfo
+	13, // 14: cdprocessor.Rip.tracks:type_name -> cdprocessor.Track
+	14, // 15: cdprocessor.RipIndex.rips:type_name -> cdprocessor.Rip
+	14, // 16: cdprocessor.GetRippedResponse.ripped:type_name -> cdprocessor.Rip
+	75, // 17: cdprocessor.MissingRecord.record:type_name -> recordcollection.Record
+	10, // 18: cdprocessor.MissingRecord.entry:type_name -> cdprocessor.QueueEntry
+	75, // 19: cdprocessor.GetMissingResponse.missing:type_name -> recordcollection.Record
+	18, // 20: cdprocessor.GetMissingResponse.queue:type_name -> cdprocessor.MissingRecord
+	1,  // 21: cdprocessor.ForceRequest.type:type_name -> cdprocessor.ForceRequest.ForceType
+	9,  // 22: cdprocessor.GetOutstandingResponse.records:type_name -> cdprocessor.Outstanding
+	2,  // 23: cdprocessor.RipEvent.type:type_name -> cdprocessor.RipEvent.EventType
+	2,  // 24: cdprocessor.WatchRipsRequest.types:type_name -> cdprocessor.RipEvent.EventType
+	27, // 25: cdprocessor.ExpectedDisk.tracks:type_name -> cdprocessor.ExpectedTrack
+	28, // 26: cdprocessor.GetRipStatusResponse.disks:type_name -> cdprocessor.ExpectedDisk
+	14, // 27: cdprocessor.GetRipStatusResponse.rips:type_name -> cdprocessor.Rip
+	10, // 28: cdprocessor.GetRipStatusResponse.queue_entry:type_name -> cdprocessor.QueueEntry
+	30, // 29: cdprocessor.ExplainLinksResponse.rules:type_name -> cdprocessor.LinkRule
+	1,  // 30: cdprocessor.BulkForceRequest.type:type_name -> cdprocessor.ForceRequest.ForceType
+	34, // 31: cdprocessor.BulkForceResponse.results:type_name -> cdprocessor.ForceResult
+	10, // 32: cdprocessor.EnqueueResponse.entry:type_name -> cdprocessor.QueueEntry
+	10, // 33: cdprocessor.SnoozeResponse.entry:type_name -> cdprocessor.QueueEntry
+	43, // 34: cdprocessor.TrackPlan.operations:type_name -> cdprocessor.FileOperation
+	44, // 35: cdprocessor.GetTagPlanResponse.tracks:type_name -> cdprocessor.TrackPlan
+	8,  // 36: cdprocessor.CompactConfigResponse.report:type_name -> cdprocessor.CompactionReport
+	3,  // 37: cdprocessor.HistoryEntry.type:type_name -> cdprocessor.HistoryEntry.Type
+	48, // 38: cdprocessor.RecordHistory.entries:type_name -> cdprocessor.HistoryEntry
+	73, // 39: cdprocessor.History.records:type_name -> cdprocessor.History.RecordsEntry
+	48, // 40: cdprocessor.GetHistoryResponse.entries:type_name -> cdprocessor.HistoryEntry
+	5,  // 41: cdprocessor.ExportConfigResponse.config:type_name -> cdprocessor.Config
+	5,  // 42: cdprocessor.ImportConfigRequest.config:type_name -> cdprocessor.Config
+	4,  // 43: cdprocessor.RipProblem.kind:type_name -> cdprocessor.RipProblem.Kind
+	57, // 44: cdprocessor.RipAudit.problems:type_name -> cdprocessor.RipProblem
+	57, // 45: cdprocessor.RipAudit.skipped:type_name -> cdprocessor.RipProblem
+	58, // 46: cdprocessor.AuditRipsResponse.audit:type_name -> cdprocessor.RipAudit
+	74, // 47: cdprocessor.Manifest.files:type_name -> cdprocessor.Manifest.FilesEntry
+	63, // 48: cdprocessor.GetScrubProgressResponse.progress:type_name -> cdprocessor.ScrubProgress
+	61, // 49: cdprocessor.GetScrubProgressResponse.problems:type_name -> cdprocessor.FileChecksum
+	10, // 50: cdprocessor.Config.ToGoDetailEntry.value:type_name -> cdprocessor.QueueEntry
+	9,  // 51: cdprocessor.Config.OutstandingEntry.value:type_name -> cdprocessor.Outstanding
+	6,  // 52: cdprocessor.Config.SuppressedEntry.value:type_name -> cdprocessor.QueueSuppression
+	49, // 53: cdprocessor.History.RecordsEntry.value:type_name -> cdprocessor.RecordHistory
+	61, // 54: cdprocessor.Manifest.FilesEntry.value:type_name -> cdprocessor.FileChecksum
+	11, // 55: cdprocessor.CDProcessor.GetRipped:input_type -> cdprocessor.GetRippedRequest
+	17, // 56: cdprocessor.CDProcessor.GetMissing:input_type -> cdprocessor.GetMissingRequest
+	20, // 57: cdprocessor.CDProcessor.Force:input_type -> cdprocessor.ForceRequest
+	22, // 58: cdprocessor.CDProcessor.GetOutstanding:input_type -> cdprocessor.GetOutstandingRequest
+	25, // 59: cdprocessor.CDProcessor.WatchRips:input_type -> cdprocessor.WatchRipsRequest
+	26, // 60: cdprocessor.CDProcessor.GetRipStatus:input_type -> cdprocessor.GetRipStatusRequest
+	31, // 61: cdprocessor.CDProcessor.ExplainLinks:input_type -> cdprocessor.ExplainLinksRequest
+	33, // 62: cdprocessor.CDProcessor.BulkForce:input_type -> cdprocessor.BulkForceRequest
+	36, // 63: cdprocessor.CDProcessor.Enqueue:input_type -> cdprocessor.EnqueueRequest
+	38, // 64: cdprocessor.CDProcessor.Dequeue:input_type -> cdprocessor.DequeueRequest
+	40, // 65: cdprocessor.CDProcessor.Snooze:input_type -> cdprocessor.SnoozeRequest
+	42, // 66: cdprocessor.CDProcessor.GetTagPlan:input_type -> cdprocessor.GetTagPlanRequest
+	46, // 67: cdprocessor.CDProcessor.CompactConfig:input_type -> cdprocessor.CompactConfigRequest
+	51, // 68: cdprocessor.CDProcessor.GetHistory:input_type -> cdprocessor.GetHistoryRequest
+	53, // 69: cdprocessor.CDProcessor.ExportConfig:input_type -> cdprocessor.ExportConfigRequest
+	55, // 70: cdprocessor.CDProcessor.ImportConfig:input_type -> cdprocessor.ImportConfigRequest
+	59, // 71: cdprocessor.CDProcessor.AuditRips:input_type -> cdprocessor.AuditRipsRequest
+	64, // 72: cdprocessor.CDProcessor.GetScrubProgress:input_type -> cdprocessor.GetScrubProgressRequest
+	16, // 73: cdprocessor.CDProcessor.GetRipped:output_type -> cdprocessor.GetRippedResponse
+	19, // 74: cdprocessor.CDProcessor.GetMissing:output_type -> cdprocessor.GetMissingResponse
+	21, // 75: cdprocessor.CDProcessor.Force:output_type -> cdprocessor.ForceResponse
+	23, // 76: cdprocessor.CDProcessor.GetOutstanding:output_type -> cdprocessor.GetOutstandingResponse
+	24, // 77: cdprocessor.CDProcessor.WatchRips:output_type -> cdprocessor.RipEvent
+	29, // 78: cdprocessor.CDProcessor.GetRipStatus:output_type -> cdprocessor.GetRipStatusResponse
+	32, // 79: cdprocessor.CDProcessor.ExplainLinks:output_type -> cdprocessor.ExplainLinksResponse
+	35, // 80: cdprocessor.CDProcessor.BulkForce:output_type -> cdprocessor.BulkForceResponse
+	37, // 81: cdprocessor.CDProcessor.Enqueue:output_type -> cdprocessor.EnqueueResponse
+	39, // 82: cdprocessor.CDProcessor.Dequeue:output_type -> cdprocessor.DequeueResponse
+	41, // 83: cdprocessor.CDProcessor.Snooze:output_type -> cdprocessor.SnoozeResponse
+	45, // 84: cdprocessor.CDProcessor.GetTagPlan:output_type -> cdprocessor.GetTagPlanResponse
+	47, // 85: cdprocessor.CDProcessor.CompactConfig:output_type -> cdprocessor.CompactConfigResponse
+	52, // 86: cdprocessor.CDProcessor.GetHistory:output_type -> cdprocessor.GetHistoryResponse
+	54, // 87: cdprocessor.CDProcessor.ExportConfig:output_type -> cdprocessor.ExportConfigResponse
+	56, // 88: cdprocessor.CDProcessor.ImportConfig:output_type -> cdprocessor.ImportConfigResponse
+	60, // 89: cdprocessor.CDProcessor.AuditRips:output_type -> cdprocessor.AuditRipsResponse
+	65, // 90: cdprocessor.CDProcessor.GetScrubProgress:output_type -> cdprocessor.GetScrubProgressResponse
+	73, // [73:91] is the sub-list for method output_type
+	55, // [55:73] is the sub-list for method input_type
+	55, // [55:55] is the sub-list for extension type_name
+	55, // [55:55] is the sub-list for extension extendee
+	0,  // [0:55] is the sub-list for field type_name
+}
+
+func init() { file_cdprocessor_proto_init() }
+func file_cdprocessor_proto_init() {
+	if File_cdprocessor_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_cdprocessor_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Config); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cdprocessor_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueueSuppression); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cdprocessor_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CompactedRecord); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cdprocessor_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CompactionReport); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cdprocessor_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Outstanding); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cdprocessor_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueueEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cdprocessor_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetRippedRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cdprocessor_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AudioInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cdprocessor_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Track); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cdprocessor_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Rip); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cdprocessor_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RipIndex); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cdprocessor_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetRippedResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cdprocessor_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMissingRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cdprocessor_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MissingRecord); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cdprocessor_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMissingResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cdprocessor_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ForceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cdprocessor_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ForceResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cdprocessor_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetOutstandingRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cdprocessor_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetOutstandingResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cdprocessor_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RipEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cdprocessor_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchRipsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cdprocessor_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetRipStatusRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cdprocessor_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExpectedTrack); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cdprocessor_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExpectedDisk); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cdprocessor_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetRipStatusResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cdprocessor_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LinkRule); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cdprocessor_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExplainLinksRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cdprocessor_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExplainLinksResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cdprocessor_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BulkForceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cdprocessor_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ForceResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cdprocessor_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BulkForceResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cdprocessor_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EnqueueRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cdprocessor_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EnqueueResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cdprocessor_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DequeueRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cdprocessor_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DequeueResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cdprocessor_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SnoozeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cdprocessor_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SnoozeResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -824,8 +5692,8 @@ func file_cdprocessor_proto_init() {
 				return nil
 			}
 		}
-		file_cdprocessor_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetRippedRequest); i {
+		file_cdprocessor_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTagPlanRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -836,8 +5704,8 @@ func file_cdprocessor_proto_init() {
 				return nil
 			}
 		}
-		file_cdprocessor_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Track); i {
+		file_cdprocessor_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FileOperation); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -848,8 +5716,8 @@ func file_cdprocessor_proto_init() {
 				return nil
 			}
 		}
-		file_cdprocessor_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Rip); i {
+		file_cdprocessor_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TrackPlan); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -860,8 +5728,8 @@ func file_cdprocessor_proto_init() {
 				return nil
 			}
 		}
-		file_cdprocessor_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetRippedResponse); i {
+		file_cdprocessor_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTagPlanResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -872,8 +5740,8 @@ func file_cdprocessor_proto_init() {
 				return nil
 			}
 		}
-		file_cdprocessor_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetMissingRequest); i {
+		file_cdprocessor_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CompactConfigRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -884,8 +5752,8 @@ func file_cdprocessor_proto_init() {
 				return nil
 			}
 		}
-		file_cdprocessor_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetMissingResponse); i {
+		file_cdprocessor_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CompactConfigResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -896,8 +5764,8 @@ func file_cdprocessor_proto_init() {
 				return nil
 			}
 		}
-		file_cdprocessor_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ForceRequest); i {
+		file_cdprocessor_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HistoryEntry); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -908,8 +5776,8 @@ func file_cdprocessor_proto_init() {
 				return nil
 			}
 		}
-		file_cdprocessor_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ForceResponse); i {
+		file_cdprocessor_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RecordHistory); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -920,8 +5788,8 @@ func file_cdprocessor_proto_init() {
 				return nil
 			}
 		}
-		file_cdprocessor_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetOutstandingRequest); i {
+		file_cdprocessor_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*History); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -932,8 +5800,176 @@ func file_cdprocessor_proto_init() {
 				return nil
 			}
 		}
-		file_cdprocessor_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetOutstandingResponse); i {
+		file_cdprocessor_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetHistoryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cdprocessor_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetHistoryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cdprocessor_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportConfigRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cdprocessor_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportConfigResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cdprocessor_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportConfigRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cdprocessor_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportConfigResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cdprocessor_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RipProblem); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cdprocessor_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RipAudit); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cdprocessor_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuditRipsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cdprocessor_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuditRipsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cdprocessor_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FileChecksum); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cdprocessor_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Manifest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cdprocessor_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScrubProgress); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cdprocessor_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetScrubProgressRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cdprocessor_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetScrubProgressResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -950,8 +5986,8 @@ func file_cdprocessor_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_cdprocessor_proto_rawDesc,
-			NumEnums:      1,
-			NumMessages:   15,
+			NumEnums:      5,
+			NumMessages:   70,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
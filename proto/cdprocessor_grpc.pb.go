@@ -19,10 +19,24 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	CDProcessor_GetRipped_FullMethodName      = "/cdprocessor.CDProcessor/GetRipped"
-	CDProcessor_GetMissing_FullMethodName     = "/cdprocessor.CDProcessor/GetMissing"
-	CDProcessor_Force_FullMethodName          = "/cdprocessor.CDProcessor/Force"
-	CDProcessor_GetOutstanding_FullMethodName = "/cdprocessor.CDProcessor/GetOutstanding"
+	CDProcessor_GetRipped_FullMethodName        = "/cdprocessor.CDProcessor/GetRipped"
+	CDProcessor_GetMissing_FullMethodName       = "/cdprocessor.CDProcessor/GetMissing"
+	CDProcessor_Force_FullMethodName            = "/cdprocessor.CDProcessor/Force"
+	CDProcessor_GetOutstanding_FullMethodName   = "/cdprocessor.CDProcessor/GetOutstanding"
+	CDProcessor_WatchRips_FullMethodName        = "/cdprocessor.CDProcessor/WatchRips"
+	CDProcessor_GetRipStatus_FullMethodName     = "/cdprocessor.CDProcessor/GetRipStatus"
+	CDProcessor_ExplainLinks_FullMethodName     = "/cdprocessor.CDProcessor/ExplainLinks"
+	CDProcessor_BulkForce_FullMethodName        = "/cdprocessor.CDProcessor/BulkForce"
+	CDProcessor_Enqueue_FullMethodName          = "/cdprocessor.CDProcessor/Enqueue"
+	CDProcessor_Dequeue_FullMethodName          = "/cdprocessor.CDProcessor/Dequeue"
+	CDProcessor_Snooze_FullMethodName           = "/cdprocessor.CDProcessor/Snooze"
+	CDProcessor_GetTagPlan_FullMethodName       = "/cdprocessor.CDProcessor/GetTagPlan"
+	CDProcessor_CompactConfig_FullMethodName    = "/cdprocessor.CDProcessor/CompactConfig"
+	CDProcessor_GetHistory_FullMethodName       = "/cdprocessor.CDProcessor/GetHistory"
+	CDProcessor_ExportConfig_FullMethodName     = "/cdprocessor.CDProcessor/ExportConfig"
+	CDProcessor_ImportConfig_FullMethodName     = "/cdprocessor.CDProcessor/ImportConfig"
+	CDProcessor_AuditRips_FullMethodName        = "/cdprocessor.CDProcessor/AuditRips"
+	CDProcessor_GetScrubProgress_FullMethodName = "/cdprocessor.CDProcessor/GetScrubProgress"
 )
 
 // CDProcessorClient is the client API for CDProcessor service.
@@ -33,6 +47,20 @@ type CDProcessorClient interface {
 	GetMissing(ctx context.Context, in *GetMissingRequest, opts ...grpc.CallOption) (*GetMissingResponse, error)
 	Force(ctx context.Context, in *ForceRequest, opts ...grpc.CallOption) (*ForceResponse, error)
 	GetOutstanding(ctx context.Context, in *GetOutstandingRequest, opts ...grpc.CallOption) (*GetOutstandingResponse, error)
+	WatchRips(ctx context.Context, in *WatchRipsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[RipEvent], error)
+	GetRipStatus(ctx context.Context, in *GetRipStatusRequest, opts ...grpc.CallOption) (*GetRipStatusResponse, error)
+	ExplainLinks(ctx context.Context, in *ExplainLinksRequest, opts ...grpc.CallOption) (*ExplainLinksResponse, error)
+	BulkForce(ctx context.Context, in *BulkForceRequest, opts ...grpc.CallOption) (*BulkForceResponse, error)
+	Enqueue(ctx context.Context, in *EnqueueRequest, opts ...grpc.CallOption) (*EnqueueResponse, error)
+	Dequeue(ctx context.Context, in *DequeueRequest, opts ...grpc.CallOption) (*DequeueResponse, error)
+	Snooze(ctx context.Context, in *SnoozeRequest, opts ...grpc.CallOption) (*SnoozeResponse, error)
+	GetTagPlan(ctx context.Context, in *GetTagPlanRequest, opts ...grpc.CallOption) (*GetTagPlanResponse, error)
+	CompactConfig(ctx context.Context, in *CompactConfigRequest, opts ...grpc.CallOption) (*CompactConfigResponse, error)
+	GetHistory(ctx context.Context, in *GetHistoryRequest, opts ...grpc.CallOption) (*GetHistoryResponse, error)
+	ExportConfig(ctx context.Context, in *ExportConfigRequest, opts ...grpc.CallOption) (*ExportConfigResponse, error)
+	ImportConfig(ctx context.Context, in *ImportConfigRequest, opts ...grpc.CallOption) (*ImportConfigResponse, error)
+	AuditRips(ctx context.Context, in *AuditRipsRequest, opts ...grpc.CallOption) (*AuditRipsResponse, error)
+	GetScrubProgress(ctx context.Context, in *GetScrubProgressRequest, opts ...grpc.CallOption) (*GetScrubProgressResponse, error)
 }
 
 type cDProcessorClient struct {
@@ -83,6 +111,155 @@ func (c *cDProcessorClient) GetOutstanding(ctx context.Context, in *GetOutstandi
 	return out, nil
 }
 
+func (c *cDProcessorClient) WatchRips(ctx context.Context, in *WatchRipsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[RipEvent], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &CDProcessor_ServiceDesc.Streams[0], CDProcessor_WatchRips_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchRipsRequest, RipEvent]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type CDProcessor_WatchRipsClient = grpc.ServerStreamingClient[RipEvent]
+
+func (c *cDProcessorClient) GetRipStatus(ctx context.Context, in *GetRipStatusRequest, opts ...grpc.CallOption) (*GetRipStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetRipStatusResponse)
+	err := c.cc.Invoke(ctx, CDProcessor_GetRipStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cDProcessorClient) ExplainLinks(ctx context.Context, in *ExplainLinksRequest, opts ...grpc.CallOption) (*ExplainLinksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExplainLinksResponse)
+	err := c.cc.Invoke(ctx, CDProcessor_ExplainLinks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cDProcessorClient) BulkForce(ctx context.Context, in *BulkForceRequest, opts ...grpc.CallOption) (*BulkForceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BulkForceResponse)
+	err := c.cc.Invoke(ctx, CDProcessor_BulkForce_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cDProcessorClient) Enqueue(ctx context.Context, in *EnqueueRequest, opts ...grpc.CallOption) (*EnqueueResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EnqueueResponse)
+	err := c.cc.Invoke(ctx, CDProcessor_Enqueue_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cDProcessorClient) Dequeue(ctx context.Context, in *DequeueRequest, opts ...grpc.CallOption) (*DequeueResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DequeueResponse)
+	err := c.cc.Invoke(ctx, CDProcessor_Dequeue_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cDProcessorClient) Snooze(ctx context.Context, in *SnoozeRequest, opts ...grpc.CallOption) (*SnoozeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SnoozeResponse)
+	err := c.cc.Invoke(ctx, CDProcessor_Snooze_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cDProcessorClient) GetTagPlan(ctx context.Context, in *GetTagPlanRequest, opts ...grpc.CallOption) (*GetTagPlanResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTagPlanResponse)
+	err := c.cc.Invoke(ctx, CDProcessor_GetTagPlan_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cDProcessorClient) CompactConfig(ctx context.Context, in *CompactConfigRequest, opts ...grpc.CallOption) (*CompactConfigResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CompactConfigResponse)
+	err := c.cc.Invoke(ctx, CDProcessor_CompactConfig_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cDProcessorClient) GetHistory(ctx context.Context, in *GetHistoryRequest, opts ...grpc.CallOption) (*GetHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetHistoryResponse)
+	err := c.cc.Invoke(ctx, CDProcessor_GetHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cDProcessorClient) ExportConfig(ctx context.Context, in *ExportConfigRequest, opts ...grpc.CallOption) (*ExportConfigResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExportConfigResponse)
+	err := c.cc.Invoke(ctx, CDProcessor_ExportConfig_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cDProcessorClient) ImportConfig(ctx context.Context, in *ImportConfigRequest, opts ...grpc.CallOption) (*ImportConfigResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImportConfigResponse)
+	err := c.cc.Invoke(ctx, CDProcessor_ImportConfig_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cDProcessorClient) AuditRips(ctx context.Context, in *AuditRipsRequest, opts ...grpc.CallOption) (*AuditRipsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AuditRipsResponse)
+	err := c.cc.Invoke(ctx, CDProcessor_AuditRips_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cDProcessorClient) GetScrubProgress(ctx context.Context, in *GetScrubProgressRequest, opts ...grpc.CallOption) (*GetScrubProgressResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetScrubProgressResponse)
+	err := c.cc.Invoke(ctx, CDProcessor_GetScrubProgress_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // CDProcessorServer is the server API for CDProcessor service.
 // All implementations should embed UnimplementedCDProcessorServer
 // for forward compatibility.
@@ -91,6 +268,20 @@ type CDProcessorServer interface {
 	GetMissing(context.Context, *GetMissingRequest) (*GetMissingResponse, error)
 	Force(context.Context, *ForceRequest) (*ForceResponse, error)
 	GetOutstanding(context.Context, *GetOutstandingRequest) (*GetOutstandingResponse, error)
+	WatchRips(*WatchRipsRequest, grpc.ServerStreamingServer[RipEvent]) error
+	GetRipStatus(context.Context, *GetRipStatusRequest) (*GetRipStatusResponse, error)
+	ExplainLinks(context.Context, *ExplainLinksRequest) (*ExplainLinksResponse, error)
+	BulkForce(context.Context, *BulkForceRequest) (*BulkForceResponse, error)
+	Enqueue(context.Context, *EnqueueRequest) (*EnqueueResponse, error)
+	Dequeue(context.Context, *DequeueRequest) (*DequeueResponse, error)
+	Snooze(context.Context, *SnoozeRequest) (*SnoozeResponse, error)
+	GetTagPlan(context.Context, *GetTagPlanRequest) (*GetTagPlanResponse, error)
+	CompactConfig(context.Context, *CompactConfigRequest) (*CompactConfigResponse, error)
+	GetHistory(context.Context, *GetHistoryRequest) (*GetHistoryResponse, error)
+	ExportConfig(context.Context, *ExportConfigRequest) (*ExportConfigResponse, error)
+	ImportConfig(context.Context, *ImportConfigRequest) (*ImportConfigResponse, error)
+	AuditRips(context.Context, *AuditRipsRequest) (*AuditRipsResponse, error)
+	GetScrubProgress(context.Context, *GetScrubProgressRequest) (*GetScrubProgressResponse, error)
 }
 
 // UnimplementedCDProcessorServer should be embedded to have
@@ -112,6 +303,48 @@ func (UnimplementedCDProcessorServer) Force(context.Context, *ForceRequest) (*Fo
 func (UnimplementedCDProcessorServer) GetOutstanding(context.Context, *GetOutstandingRequest) (*GetOutstandingResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetOutstanding not implemented")
 }
+func (UnimplementedCDProcessorServer) WatchRips(*WatchRipsRequest, grpc.ServerStreamingServer[RipEvent]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchRips not implemented")
+}
+func (UnimplementedCDProcessorServer) GetRipStatus(context.Context, *GetRipStatusRequest) (*GetRipStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRipStatus not implemented")
+}
+func (UnimplementedCDProcessorServer) ExplainLinks(context.Context, *ExplainLinksRequest) (*ExplainLinksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExplainLinks not implemented")
+}
+func (UnimplementedCDProcessorServer) BulkForce(context.Context, *BulkForceRequest) (*BulkForceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BulkForce not implemented")
+}
+func (UnimplementedCDProcessorServer) Enqueue(context.Context, *EnqueueRequest) (*EnqueueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Enqueue not implemented")
+}
+func (UnimplementedCDProcessorServer) Dequeue(context.Context, *DequeueRequest) (*DequeueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Dequeue not implemented")
+}
+func (UnimplementedCDProcessorServer) Snooze(context.Context, *SnoozeRequest) (*SnoozeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Snooze not implemented")
+}
+func (UnimplementedCDProcessorServer) GetTagPlan(context.Context, *GetTagPlanRequest) (*GetTagPlanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTagPlan not implemented")
+}
+func (UnimplementedCDProcessorServer) CompactConfig(context.Context, *CompactConfigRequest) (*CompactConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompactConfig not implemented")
+}
+func (UnimplementedCDProcessorServer) GetHistory(context.Context, *GetHistoryRequest) (*GetHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetHistory not implemented")
+}
+func (UnimplementedCDProcessorServer) ExportConfig(context.Context, *ExportConfigRequest) (*ExportConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportConfig not implemented")
+}
+func (UnimplementedCDProcessorServer) ImportConfig(context.Context, *ImportConfigRequest) (*ImportConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportConfig not implemented")
+}
+func (UnimplementedCDProcessorServer) AuditRips(context.Context, *AuditRipsRequest) (*AuditRipsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AuditRips not implemented")
+}
+func (UnimplementedCDProcessorServer) GetScrubProgress(context.Context, *GetScrubProgressRequest) (*GetScrubProgressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetScrubProgress not implemented")
+}
 func (UnimplementedCDProcessorServer) testEmbeddedByValue() {}
 
 // UnsafeCDProcessorServer may be embedded to opt out of forward compatibility for this service.
@@ -204,6 +437,251 @@ func _CDProcessor_GetOutstanding_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CDProcessor_WatchRips_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchRipsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(CDProcessorServer).WatchRips(m, &grpc.GenericServerStream[WatchRipsRequest, RipEvent]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type CDProcessor_WatchRipsServer = grpc.ServerStreamingServer[RipEvent]
+
+func _CDProcessor_GetRipStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRipStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CDProcessorServer).GetRipStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CDProcessor_GetRipStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CDProcessorServer).GetRipStatus(ctx, req.(*GetRipStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CDProcessor_ExplainLinks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExplainLinksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CDProcessorServer).ExplainLinks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CDProcessor_ExplainLinks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CDProcessorServer).ExplainLinks(ctx, req.(*ExplainLinksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CDProcessor_BulkForce_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BulkForceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CDProcessorServer).BulkForce(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CDProcessor_BulkForce_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CDProcessorServer).BulkForce(ctx, req.(*BulkForceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CDProcessor_Enqueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EnqueueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CDProcessorServer).Enqueue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CDProcessor_Enqueue_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CDProcessorServer).Enqueue(ctx, req.(*EnqueueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CDProcessor_Dequeue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DequeueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CDProcessorServer).Dequeue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CDProcessor_Dequeue_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CDProcessorServer).Dequeue(ctx, req.(*DequeueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CDProcessor_Snooze_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SnoozeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CDProcessorServer).Snooze(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CDProcessor_Snooze_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CDProcessorServer).Snooze(ctx, req.(*SnoozeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CDProcessor_GetTagPlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTagPlanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CDProcessorServer).GetTagPlan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CDProcessor_GetTagPlan_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CDProcessorServer).GetTagPlan(ctx, req.(*GetTagPlanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CDProcessor_CompactConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompactConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CDProcessorServer).CompactConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CDProcessor_CompactConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CDProcessorServer).CompactConfig(ctx, req.(*CompactConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CDProcessor_GetHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CDProcessorServer).GetHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CDProcessor_GetHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CDProcessorServer).GetHistory(ctx, req.(*GetHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CDProcessor_ExportConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CDProcessorServer).ExportConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CDProcessor_ExportConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CDProcessorServer).ExportConfig(ctx, req.(*ExportConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CDProcessor_ImportConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CDProcessorServer).ImportConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CDProcessor_ImportConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CDProcessorServer).ImportConfig(ctx, req.(*ImportConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CDProcessor_AuditRips_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuditRipsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CDProcessorServer).AuditRips(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CDProcessor_AuditRips_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CDProcessorServer).AuditRips(ctx, req.(*AuditRipsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CDProcessor_GetScrubProgress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetScrubProgressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CDProcessorServer).GetScrubProgress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CDProcessor_GetScrubProgress_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CDProcessorServer).GetScrubProgress(ctx, req.(*GetScrubProgressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // CDProcessor_ServiceDesc is the grpc.ServiceDesc for CDProcessor service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -227,7 +705,65 @@ var CDProcessor_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetOutstanding",
 			Handler:    _CDProcessor_GetOutstanding_Handler,
 		},
+		{
+			MethodName: "GetRipStatus",
+			Handler:    _CDProcessor_GetRipStatus_Handler,
+		},
+		{
+			MethodName: "ExplainLinks",
+			Handler:    _CDProcessor_ExplainLinks_Handler,
+		},
+		{
+			MethodName: "BulkForce",
+			Handler:    _CDProcessor_BulkForce_Handler,
+		},
+		{
+			MethodName: "Enqueue",
+			Handler:    _CDProcessor_Enqueue_Handler,
+		},
+		{
+			MethodName: "Dequeue",
+			Handler:    _CDProcessor_Dequeue_Handler,
+		},
+		{
+			MethodName: "Snooze",
+			Handler:    _CDProcessor_Snooze_Handler,
+		},
+		{
+			MethodName: "GetTagPlan",
+			Handler:    _CDProcessor_GetTagPlan_Handler,
+		},
+		{
+			MethodName: "CompactConfig",
+			Handler:    _CDProcessor_CompactConfig_Handler,
+		},
+		{
+			MethodName: "GetHistory",
+			Handler:    _CDProcessor_GetHistory_Handler,
+		},
+		{
+			MethodName: "ExportConfig",
+			Handler:    _CDProcessor_ExportConfig_Handler,
+		},
+		{
+			MethodName: "ImportConfig",
+			Handler:    _CDProcessor_ImportConfig_Handler,
+		},
+		{
+			MethodName: "AuditRips",
+			Handler:    _CDProcessor_AuditRips_Handler,
+		},
+		{
+			MethodName: "GetScrubProgress",
+			Handler:    _CDProcessor_GetScrubProgress_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchRips",
+			Handler:       _CDProcessor_WatchRips_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "cdprocessor.proto",
 }
@@ -0,0 +1,166 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/brotherlogic/goserver/utils"
+	"golang.org/x/net/context"
+
+	pb "github.com/brotherlogic/cdprocessor/proto"
+)
+
+// auditRipID works out which release a rip id belongs to, returning a problem if it's
+// an orphan or named for the instance rather than the release
+func (s *Server) auditRipID(ctx context.Context, id int32) (int32, *pb.RipProblem, error) {
+	instances, err := s.rc.getInstanceIds(ctx, id)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	if len(instances) > 0 {
+		var reasons []string
+		for _, instance := range instances {
+			reason, err := s.deadReason(ctx, instance)
+			if err != nil {
+				return 0, nil, err
+			}
+			if len(reason) == 0 {
+				return id, nil, nil
+			}
+			reasons = append(reasons, fmt.Sprintf("%v is %v", instance, reason))
+		}
+		return id, &pb.RipProblem{Kind: pb.RipProblem_ORPHAN, RipId: id, ReleaseId: id, Detail: strings.Join(reasons, ", ")}, nil
+	}
+
+	// No release by that id, so see if it's an instance id
+	reason, err := s.deadReason(ctx, id)
+	if err != nil {
+		return 0, nil, err
+	}
+	if len(reason) > 0 {
+		return 0, &pb.RipProblem{Kind: pb.RipProblem_ORPHAN, RipId: id, Detail: reason}, nil
+	}
+
+	record, err := s.getter.getRecord(ctx, id)
+	if err != nil {
+		return 0, nil, err
+	}
+	releaseID := record.GetRelease().GetId()
+	return releaseID, &pb.RipProblem{Kind: pb.RipProblem_INSTANCE_ID, RipId: id, ReleaseId: releaseID,
+		Detail: fmt.Sprintf("%v is an instance of release %v", id, releaseID)}, nil
+}
+
+// ripDisk is the disk a rip holds, allowing for rips indexed before we recorded it
+func ripDisk(rip *pb.Rip) int32 {
+	if rip.GetDisk() > 0 {
+		return rip.GetDisk()
+	}
+	if len(rip.GetTracks()) > 0 {
+		return rip.GetTracks()[0].GetDisk()
+	}
+	return 1
+}
+
+// auditRips cross references every rip against the collection, skipping any rip ids
+// which can't be looked up
+func (s *Server) auditRips(ctx context.Context) *pb.RipAudit {
+	rips := s.getRips()
+
+	paths := make(map[int32][]*pb.Rip)
+	var ids []int32
+	for _, rip := range rips {
+		if _, ok := paths[rip.GetId()]; !ok {
+			ids = append(ids, rip.GetId())
+		}
+		paths[rip.GetId()] = append(paths[rip.GetId()], rip)
+	}
+	sort.Slice(ids, func(i, j int) bool {
+		return ids[i] < ids[j]
+	})
+
+	type diskKey struct {
+		releaseID int32
+		disk      int32
+	}
+	audit := &pb.RipAudit{RunTime: time.Now().Unix()}
+	disks := make(map[diskKey][]string)
+	var keys []diskKey
+	for _, id := range ids {
+		audit.Checked++
+		releaseID, problem, err := s.auditRipID(ctx, id)
+		if err != nil {
+			s.CtxLog(ctx, fmt.Sprintf("Unable to audit %v: %v", id, err))
+			skipped := &pb.RipProblem{RipId: id, Detail: err.Error()}
+			for _, rip := range paths[id] {
+				skipped.Paths = append(skipped.Paths, rip.GetPath())
+			}
+			audit.Skipped = append(audit.Skipped, skipped)
+			continue
+		}
+
+		if problem != nil {
+			for _, rip := range paths[id] {
+				problem.Paths = append(problem.Paths, rip.GetPath())
+			}
+			audit.Problems = append(audit.Problems, problem)
+		}
+
+		if releaseID > 0 {
+			for _, rip := range paths[id] {
+				key := diskKey{releaseID: releaseID, disk: ripDisk(rip)}
+				if _, ok := disks[key]; !ok {
+					keys = append(keys, key)
+				}
+				disks[key] = append(disks[key], rip.GetPath())
+			}
+		}
+	}
+
+	for _, key := range keys {
+		if len(disks[key]) > 1 {
+			audit.Problems = append(audit.Problems, &pb.RipProblem{Kind: pb.RipProblem_DUPLICATE, ReleaseId: key.releaseID, Paths: disks[key],
+				Detail: fmt.Sprintf("disk %v of %v is in %v directories", key.disk, key.releaseID, len(disks[key]))})
+		}
+	}
+
+	return audit
+}
+
+// AuditRips checks every rip directory against the collection
+func (s *Server) AuditRips(ctx context.Context, req *pb.AuditRipsRequest) (*pb.AuditRipsResponse, error) {
+	return &pb.AuditRipsResponse{Audit: s.auditRips(ctx)}, nil
+}
+
+// auditSummary lists the problems an audit found, one per line in a stable order
+func auditSummary(audit *pb.RipAudit) string {
+	var lines []string
+	for _, problem := range audit.GetProblems() {
+		lines = append(lines, fmt.Sprintf("%v %v: %v", problem.GetKind(), problem.GetPaths(), problem.GetDetail()))
+	}
+	sort.Strings(lines)
+	return strings.Join(lines, "\n")
+}
+
+// runAudit audits the rips at startup and once a day after, raising an issue whenever the
+// problems it finds change
+func (s *Server) runAudit() {
+	raised := ""
+	for {
+		ctx, cancel := utils.ManualContext("cdprocessor-audit", time.Hour)
+		audit := s.auditRips(ctx)
+		if len(audit.GetSkipped()) > 0 {
+			s.CtxLog(ctx, fmt.Sprintf("Audit skipped %v rip ids", len(audit.GetSkipped())))
+		}
+		summary := auditSummary(audit)
+		if len(summary) > 0 && summary != raised {
+			s.RaiseIssue("Rip directory problems", summary)
+		}
+		raised = summary
+		cancel()
+
+		time.Sleep(time.Hour * 24)
+	}
+}
@@ -0,0 +1,97 @@
+package main
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	pb "github.com/brotherlogic/cdprocessor/proto"
+	pbgd "github.com/brotherlogic/godiscogs/proto"
+	pbrc "github.com/brotherlogic/recordcollection/proto"
+)
+
+func TestAuditRips(t *testing.T) {
+	dir, err := os.MkdirTemp("", "cdprocessor")
+	if err != nil {
+		t.Fatalf("Unable to make dir: %v", err)
+	}
+	defer os.RemoveAll(dir)
+	dir += "/"
+
+	for _, path := range []string{"100", "100 - Again", "200", "3001", "400"} {
+		os.MkdirAll(filepath.Join(dir, path), 0755)
+		os.WriteFile(filepath.Join(dir, path, "track01.cdda.wav"), []byte{}, 0644)
+	}
+
+	s := InitTestServer(dir)
+	s.io = &prodIo{dir: dir, layouts: s.layouts}
+	s.rips = nil
+	s.buildConfig(context.Background())
+
+	s.rc = &testRc{instances: map[int32][]int32{100: {1001}, 200: {2001}}}
+	s.getter = &testGetter{
+		missing: map[int32]bool{400: true},
+		records: map[int32]*pbrc.Record{
+			1001: {Release: &pbgd.Release{Id: 100, InstanceId: 1001}, Metadata: &pbrc.ReleaseMetadata{Category: pbrc.ReleaseMetadata_IN_COLLECTION}},
+			2001: {Release: &pbgd.Release{Id: 200, InstanceId: 2001}, Metadata: &pbrc.ReleaseMetadata{Category: pbrc.ReleaseMetadata_SOLD_ARCHIVE}},
+			3001: {Release: &pbgd.Release{Id: 300, InstanceId: 3001}, Metadata: &pbrc.ReleaseMetadata{Category: pbrc.ReleaseMetadata_IN_COLLECTION}},
+		},
+	}
+
+	resp, err := s.AuditRips(context.Background(), &pb.AuditRipsRequest{})
+	if err != nil {
+		t.Fatalf("Bad audit: %v", err)
+	}
+
+	audit := resp.GetAudit()
+	if audit.GetChecked() != 4 {
+		t.Errorf("Wrong number checked: %v", audit.GetChecked())
+	}
+
+	expected := []struct {
+		kind  pb.RipProblem_Kind
+		paths int
+		id    int32
+	}{
+		{pb.RipProblem_ORPHAN, 1, 200},
+		{pb.RipProblem_ORPHAN, 1, 0},
+		{pb.RipProblem_INSTANCE_ID, 1, 300},
+		{pb.RipProblem_DUPLICATE, 2, 100},
+	}
+	if len(audit.GetProblems()) != len(expected) {
+		t.Fatalf("Wrong problems: %v", audit.GetProblems())
+	}
+	for i, e := range expected {
+		problem := audit.GetProblems()[i]
+		if problem.GetKind() != e.kind || len(problem.GetPaths()) != e.paths || problem.GetReleaseId() != e.id {
+			t.Errorf("Bad problem %v: %v", i, problem)
+		}
+	}
+}
+
+func TestAuditRipsFail(t *testing.T) {
+	s := InitTestServer("testdata/")
+	s.rc = &testRc{failGet: true}
+
+	resp, err := s.AuditRips(context.Background(), &pb.AuditRipsRequest{})
+	if err != nil || len(resp.GetAudit().GetSkipped()) != 1 || len(resp.GetAudit().GetProblems()) != 0 {
+		t.Errorf("Failed lookup was not skipped: %v -> %v", resp, err)
+	}
+}
+
+func TestAuditSummary(t *testing.T) {
+	first := &pb.RipAudit{RunTime: 1, Problems: []*pb.RipProblem{
+		{Kind: pb.RipProblem_ORPHAN, Paths: []string{"12"}, Detail: "sold"},
+		{Kind: pb.RipProblem_DUPLICATE, Paths: []string{"13", "13_1"}, Detail: "twice"},
+	}}
+	second := &pb.RipAudit{RunTime: 2, Problems: []*pb.RipProblem{first.GetProblems()[1], first.GetProblems()[0]},
+		Skipped: []*pb.RipProblem{{RipId: 14, Detail: "lookup failed"}}}
+
+	if auditSummary(first) != auditSummary(second) {
+		t.Errorf("Same problems gave different summaries: %v vs %v", auditSummary(first), auditSummary(second))
+	}
+	if len(auditSummary(&pb.RipAudit{})) != 0 {
+		t.Errorf("Clean audit has a summary")
+	}
+}
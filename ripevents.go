@@ -0,0 +1,78 @@
+package main
+
+import (
+	"time"
+
+	pb "github.com/brotherlogic/cdprocessor/proto"
+	"google.golang.org/grpc"
+)
+
+// The number of events a slow watcher can fall behind before we drop events
+const watchBuffer = 100
+
+func (s *Server) subscribe() (int, chan *pb.RipEvent) {
+	s.watchLock.Lock()
+	defer s.watchLock.Unlock()
+
+	s.watchCount++
+	ch := make(chan *pb.RipEvent, watchBuffer)
+	s.watchers[s.watchCount] = ch
+	return s.watchCount, ch
+}
+
+func (s *Server) unsubscribe(key int) {
+	s.watchLock.Lock()
+	defer s.watchLock.Unlock()
+	delete(s.watchers, key)
+}
+
+// publish sends the event to every watcher, without blocking on slow ones
+func (s *Server) publish(event *pb.RipEvent) {
+	event.Timestamp = time.Now().Unix()
+	s.historyFromEvent(event)
+
+	s.watchLock.Lock()
+	defer s.watchLock.Unlock()
+	for _, ch := range s.watchers {
+		select {
+		case ch <- event:
+		default:
+		}
+	}
+}
+
+func matchesEvent(event *pb.RipEvent, req *pb.WatchRipsRequest) bool {
+	if req.GetId() != 0 && event.GetId() != req.GetId() && event.GetInstanceId() != req.GetId() {
+		return false
+	}
+
+	if len(req.GetTypes()) == 0 {
+		return true
+	}
+	for _, t := range req.GetTypes() {
+		if t == event.GetType() {
+			return true
+		}
+	}
+	return false
+}
+
+// WatchRips streams rip and conversion events until the caller goes away
+func (s *Server) WatchRips(req *pb.WatchRipsRequest, stream grpc.ServerStreamingServer[pb.RipEvent]) error {
+	key, ch := s.subscribe()
+	defer s.unsubscribe(key)
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case event := <-ch:
+			if matchesEvent(event, req) {
+				err := stream.Send(event)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+}
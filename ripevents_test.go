@@ -0,0 +1,68 @@
+package main
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	pb "github.com/brotherlogic/cdprocessor/proto"
+	"google.golang.org/grpc"
+)
+
+type testStream struct {
+	grpc.ServerStream
+	ctx    context.Context
+	events chan *pb.RipEvent
+}
+
+func (t *testStream) Context() context.Context {
+	return t.ctx
+}
+
+func (t *testStream) Send(event *pb.RipEvent) error {
+	t.events <- event
+	return nil
+}
+
+func TestWatchRips(t *testing.T) {
+	s := InitTestServer("testdata/")
+	ctx, cancel := context.WithCancel(context.Background())
+	stream := &testStream{ctx: ctx, events: make(chan *pb.RipEvent, 10)}
+
+	done := make(chan error)
+	go func() {
+		done <- s.WatchRips(&pb.WatchRipsRequest{Id: 12345, Types: []pb.RipEvent_EventType{pb.RipEvent_MP3_QUEUED}}, stream)
+	}()
+
+	for {
+		s.watchLock.Lock()
+		count := len(s.watchers)
+		s.watchLock.Unlock()
+		if count > 0 {
+			break
+		}
+		time.Sleep(time.Millisecond)
+	}
+
+	s.publish(&pb.RipEvent{Type: pb.RipEvent_FLAC_QUEUED, Id: 12345})
+	s.publish(&pb.RipEvent{Type: pb.RipEvent_MP3_QUEUED, Id: 12346})
+	s.convertToMP3(context.Background(), 12345)
+
+	select {
+	case event := <-stream.events:
+		if event.GetType() != pb.RipEvent_MP3_QUEUED || event.GetId() != 12345 || event.GetTrack() != 3 {
+			t.Errorf("Bad event: %v", event)
+		}
+	case <-time.After(time.Second * 5):
+		t.Fatalf("No event received")
+	}
+
+	cancel()
+	if err := <-done; err != nil {
+		t.Errorf("Bad watch: %v", err)
+	}
+
+	if len(s.watchers) != 0 {
+		t.Errorf("Watcher was not removed: %v", s.watchers)
+	}
+}
@@ -0,0 +1,56 @@
+package main
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/brotherlogic/goserver/utils"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/brotherlogic/cdprocessor/proto"
+)
+
+// RIPS - under which each node stores the index of its own rips
+const RIPS = "/github.com/brotherlogic/cdprocessor/rips"
+
+// ripsKey is where this node's rip index is stored
+func (s *Server) ripsKey() string {
+	return fmt.Sprintf("%v/%v", RIPS, s.node)
+}
+
+func (s *Server) saveRipIndex(ctx context.Context) error {
+	return s.store.save(ctx, s.ripsKey(), &pb.RipIndex{Rips: s.getRips(), BuildTime: time.Now().Unix()})
+}
+
+// loadRipIndex serves the rips from the last scan until the directory has been rescanned
+func (s *Server) loadRipIndex(ctx context.Context) error {
+	data, err := s.store.load(ctx, s.ripsKey(), &pb.RipIndex{})
+	if err != nil {
+		code := status.Convert(err).Code()
+		if code == codes.NotFound || code == codes.InvalidArgument {
+			return nil
+		}
+		return err
+	}
+
+	index := data.(*pb.RipIndex)
+	s.setRips(index.GetRips())
+	s.CtxLog(ctx, fmt.Sprintf("Loaded %v rips indexed at %v", len(index.GetRips()), time.Unix(index.GetBuildTime(), 0)))
+	return nil
+}
+
+// reconcileRips brings the index up to date with what's on disk
+func (s *Server) reconcileRips() {
+	ctx, cancel := utils.ManualContext("cdprocessor-rips", time.Hour)
+	defer cancel()
+
+	t := time.Now()
+	err := s.buildConfig(ctx)
+	if err != nil {
+		s.CtxLog(ctx, fmt.Sprintf("Unable to reconcile rips: %v", err))
+		return
+	}
+	s.CtxLog(ctx, fmt.Sprintf("Reconciled %v rips in %v", len(s.getRips()), time.Since(t)))
+}
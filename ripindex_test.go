@@ -0,0 +1,194 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+
+	pb "github.com/brotherlogic/cdprocessor/proto"
+)
+
+type countingIo struct {
+	testIo
+	reads int
+}
+
+func (c *countingIo) readSubdir(f string) ([]os.FileInfo, error) {
+	c.reads++
+	return c.testIo.readSubdir(f)
+}
+
+type flakyIo struct {
+	testIo
+	fail map[string]bool
+}
+
+func (f *flakyIo) readSubdir(name string) ([]os.FileInfo, error) {
+	if f.fail[name] {
+		return nil, fmt.Errorf("Built to fail")
+	}
+	// Finish out of order, the index should not care
+	id, _ := strconv.Atoi(name)
+	time.Sleep(time.Millisecond * time.Duration(20-id%20))
+	return f.testIo.readSubdir(name)
+}
+
+func TestRipIndexSurvivesRestart(t *testing.T) {
+	s := InitTestServer("testdata/")
+	if len(s.rips) != 1 {
+		t.Fatalf("Bad initial scan: %v", s.rips)
+	}
+
+	restarted := Init("testdata/", "testdata/mp3", "testdata/flac")
+	restarted.SkipLog = true
+	restarted.store = s.store
+	err := restarted.loadRipIndex(context.Background())
+	if err != nil {
+		t.Fatalf("Bad load: %v", err)
+	}
+
+	resp, err := restarted.GetRipped(context.Background(), &pb.GetRippedRequest{})
+	if err != nil || len(resp.GetRipped()) != 1 || len(resp.GetRipped()[0].GetTracks()) != 3 {
+		t.Errorf("Index was not served before a scan: %v -> %v", resp, err)
+	}
+}
+
+func TestScanRereadsRewrittenFiles(t *testing.T) {
+	dir := t.TempDir() + "/"
+	os.MkdirAll(filepath.Join(dir, "123"), 0755)
+	wav := filepath.Join(dir, "123", "track01.cdda.wav")
+	os.WriteFile(wav, makeWav(44100, 2, 16, 1), 0644)
+
+	s := InitTestServer(dir)
+	cio := &countingIo{testIo: testIo{dir: dir}}
+	s.io = cio
+	if s.getRips()[0].GetTracks()[0].GetWav().GetDurationMs() != 1000 {
+		t.Fatalf("Bad initial scan: %v", s.getRips())
+	}
+
+	// Rewrite the wav in place, leaving the directory as it was
+	info, _ := os.Stat(filepath.Join(dir, "123"))
+	later := time.Now().Add(time.Minute)
+	os.WriteFile(wav, makeWav(44100, 2, 16, 3), 0644)
+	os.Chtimes(wav, later, later)
+	os.Chtimes(filepath.Join(dir, "123"), info.ModTime(), info.ModTime())
+
+	s.buildConfig(context.Background())
+	if cio.reads != 0 || s.getRips()[0].GetTracks()[0].GetWav().GetDurationMs() != 3000 {
+		t.Errorf("Rewritten file was not reread (%v listings): %v", cio.reads, s.getRips())
+	}
+
+	// With nothing changed the rip is left as it was
+	before := s.getRips()[0]
+	s.buildConfig(context.Background())
+	if s.getRips()[0] != before {
+		t.Errorf("Unchanged rip was rebuilt")
+	}
+}
+
+func TestRipIndexPerNode(t *testing.T) {
+	s := InitTestServer("testdata/")
+	s.saveRipIndex(context.Background())
+
+	other := Init("testdata/", "testdata/mp3", "testdata/flac")
+	other.SkipLog = true
+	other.store = s.store
+	other.node = s.node + "-other"
+	err := other.loadRipIndex(context.Background())
+	if err != nil || len(other.getRips()) != 0 {
+		t.Errorf("Another node's index was loaded: %v -> %v", other.getRips(), err)
+	}
+}
+
+func TestIncrementalScan(t *testing.T) {
+	dir, err := os.MkdirTemp("", "cdprocessor")
+	if err != nil {
+		t.Fatalf("Unable to make dir: %v", err)
+	}
+	defer os.RemoveAll(dir)
+	dir += "/"
+
+	os.MkdirAll(filepath.Join(dir, "123"), 0755)
+	os.WriteFile(filepath.Join(dir, "123", "track01.cdda.wav"), []byte{}, 0644)
+
+	s := InitTestServer(dir)
+	cio := &countingIo{testIo: testIo{dir: dir}}
+	s.io = cio
+
+	s.buildConfig(context.Background())
+	if cio.reads != 0 {
+		t.Errorf("Unchanged rip was reread %v times", cio.reads)
+	}
+
+	os.WriteFile(filepath.Join(dir, "123", "track01.cdda.mp3"), []byte{}, 0644)
+	future := time.Now().Add(time.Minute)
+	os.Chtimes(filepath.Join(dir, "123"), future, future)
+
+	s.buildConfig(context.Background())
+	if cio.reads != 1 || len(s.rips) != 1 || s.rips[0].GetTracks()[0].GetMp3Path() != "123/track01.cdda.mp3" {
+		t.Errorf("Changed rip was not reread (%v): %v", cio.reads, s.rips)
+	}
+
+	os.RemoveAll(filepath.Join(dir, "123"))
+	s.buildConfig(context.Background())
+	if len(s.rips) != 0 {
+		t.Errorf("Removed rip is still indexed: %v", s.rips)
+	}
+
+	data, err := s.store.load(context.Background(), s.ripsKey(), &pb.RipIndex{})
+	if err != nil || len(data.(*pb.RipIndex).GetRips()) != 0 {
+		t.Errorf("Removal was not saved: %v -> %v", data, err)
+	}
+}
+
+func TestConcurrentScan(t *testing.T) {
+	dir, err := os.MkdirTemp("", "cdprocessor")
+	if err != nil {
+		t.Fatalf("Unable to make dir: %v", err)
+	}
+	defer os.RemoveAll(dir)
+	dir += "/"
+
+	for i := 100; i < 140; i++ {
+		os.MkdirAll(filepath.Join(dir, fmt.Sprintf("%v", i)), 0755)
+		os.WriteFile(filepath.Join(dir, fmt.Sprintf("%v", i), "track01.cdda.wav"), []byte{}, 0644)
+	}
+
+	s := InitTestServer(dir)
+	s.scanWorkers = 8
+	fio := &flakyIo{testIo: testIo{dir: dir}, fail: map[string]bool{"105": true}}
+	s.io = fio
+	s.rips = []*pb.Rip{}
+
+	err = s.buildConfig(context.Background())
+	if err == nil {
+		t.Errorf("Failed read was not reported")
+	}
+	if len(s.rips) != 39 {
+		t.Fatalf("Wrong number of rips: %v", len(s.rips))
+	}
+	for i := 1; i < len(s.rips); i++ {
+		if s.rips[i-1].GetId() >= s.rips[i].GetId() {
+			t.Errorf("Rips are out of order: %v then %v", s.rips[i-1].GetId(), s.rips[i].GetId())
+		}
+	}
+
+	// Once it reads, the rip comes back; a failure after that keeps the old tracks
+	fio.fail = map[string]bool{}
+	err = s.buildConfig(context.Background())
+	if err != nil || len(s.rips) != 40 {
+		t.Fatalf("Bad rescan (%v): %v", len(s.rips), err)
+	}
+
+	fio.fail = map[string]bool{"105": true}
+	future := time.Now().Add(time.Minute)
+	os.Chtimes(filepath.Join(dir, "105"), future, future)
+	err = s.buildConfig(context.Background())
+	if err == nil || len(s.rips) != 40 || s.rips[5].GetScanError() == "" || len(s.rips[5].GetTracks()) != 1 {
+		t.Errorf("Failed rescan lost the rip: %v -> %v", s.rips[5], err)
+	}
+}
@@ -0,0 +1,94 @@
+package main
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// ripLayout is a naming scheme for rip directories; the pattern is matched against the
+// path under the rips directory and must have an id group, with optional disk and slug groups
+type ripLayout struct {
+	name    string
+	pattern *regexp.Regexp
+}
+
+// defaultLayouts are tried after any given with -layout, in order
+var defaultLayouts = []*ripLayout{
+	// 12345/disc1, 12345 - Some Album/CD 2
+	{name: "nested", pattern: regexp.MustCompile(`(?i)^(?P<id>\d+)(?:[ _-][^/]*)?/(?:disc|disk|cd)[ _-]?(?P<disk>\d+)$`)},
+	{name: "plain", pattern: regexp.MustCompile(`^(?P<id>\d+)$`)},
+	{name: "disk", pattern: regexp.MustCompile(`^(?P<id>\d+)_(?P<disk>\d+)$`)},
+	// 12345_2_bonus
+	{name: "disk_slug", pattern: regexp.MustCompile(`^(?P<id>\d+)_(?P<disk>\d+)_(?P<slug>[^/]+)$`)},
+	// 12345 - Artist - Album, 12345_bonus
+	{name: "slug", pattern: regexp.MustCompile(`^(?P<id>\d+)[ _-]+(?P<slug>[^/\d][^/]*)$`)},
+}
+
+// parseLayout builds a layout from a name=pattern flag value
+func parseLayout(val string) (*ripLayout, error) {
+	parts := strings.SplitN(val, "=", 2)
+	if len(parts) != 2 || len(parts[0]) == 0 {
+		return nil, fmt.Errorf("layout %q should be name=pattern", val)
+	}
+
+	pattern, err := regexp.Compile(parts[1])
+	if err != nil {
+		return nil, fmt.Errorf("bad pattern for layout %v: %w", parts[0], err)
+	}
+	if pattern.SubexpIndex("id") < 0 {
+		return nil, fmt.Errorf("layout %v has no id group", parts[0])
+	}
+	return &ripLayout{name: parts[0], pattern: pattern}, nil
+}
+
+// layoutFlag collects the layouts given on the command line
+type layoutFlag []*ripLayout
+
+func (l *layoutFlag) String() string {
+	var names []string
+	for _, layout := range *l {
+		names = append(names, layout.name)
+	}
+	return strings.Join(names, ",")
+}
+
+func (l *layoutFlag) Set(val string) error {
+	layout, err := parseLayout(val)
+	if err != nil {
+		return err
+	}
+	*l = append(*l, layout)
+	return nil
+}
+
+// matchLayout finds the rip a directory holds, using the first layout which matches
+func matchLayout(layouts []*ripLayout, path string) (int32, int32, string, error) {
+	for _, layout := range layouts {
+		match := layout.pattern.FindStringSubmatch(path)
+		if match == nil {
+			continue
+		}
+
+		id, err := strconv.ParseInt(match[layout.pattern.SubexpIndex("id")], 10, 32)
+		if err != nil {
+			return -1, -1, "", fmt.Errorf("bad id in %v (%v layout): %w", path, layout.name, err)
+		}
+
+		disk := int64(1)
+		if i := layout.pattern.SubexpIndex("disk"); i >= 0 && len(match[i]) > 0 {
+			disk, err = strconv.ParseInt(match[i], 10, 32)
+			if err != nil {
+				return -1, -1, "", fmt.Errorf("bad disk in %v (%v layout): %w", path, layout.name, err)
+			}
+		}
+
+		slug := ""
+		if i := layout.pattern.SubexpIndex("slug"); i >= 0 {
+			slug = match[i]
+		}
+		return int32(id), int32(disk), slug, nil
+	}
+	return -1, -1, "", fmt.Errorf("%v does not match any rip layout", path)
+}
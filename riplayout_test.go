@@ -0,0 +1,161 @@
+package main
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	pbgd "github.com/brotherlogic/godiscogs/proto"
+	pbrc "github.com/brotherlogic/recordcollection/proto"
+)
+
+func TestMatchLayout(t *testing.T) {
+	tests := []struct {
+		path string
+		id   int32
+		disk int32
+		slug string
+	}{
+		{"12345", 12345, 1, ""},
+		{"12345_2", 12345, 2, ""},
+		{"12345_2_bonus", 12345, 2, "bonus"},
+		{"12345 - Some Album", 12345, 1, "Some Album"},
+		{"12345_bonus", 12345, 1, "bonus"},
+		{"12345/disc1", 12345, 1, ""},
+		{"12345 - Some Album/CD 2", 12345, 2, ""},
+	}
+
+	for _, test := range tests {
+		id, disk, slug, err := matchLayout(defaultLayouts, test.path)
+		if err != nil || id != test.id || disk != test.disk || slug != test.slug {
+			t.Errorf("Bad match of %v: %v, %v, %q, %v", test.path, id, disk, slug, err)
+		}
+	}
+
+	for _, bad := range []string{"abc", "12345/extras", "lost+found", "12345_2_bonus/disc1/more"} {
+		id, disk, slug, err := matchLayout(defaultLayouts, bad)
+		if err == nil {
+			t.Errorf("Matched %v as %v %v %q", bad, id, disk, slug)
+		}
+	}
+}
+
+func TestParseLayout(t *testing.T) {
+	layout, err := parseLayout(`vinyl=^v(?P<id>\d+)$`)
+	if err != nil || layout.name != "vinyl" {
+		t.Fatalf("Bad layout: %v -> %v", layout, err)
+	}
+
+	for _, bad := range []string{`^v(\d+)$`, `vinyl=^v(\d+)$`, `vinyl=^v(?P<id>\d+$`, `=^(?P<id>\d+)$`} {
+		if layout, err := parseLayout(bad); err == nil {
+			t.Errorf("Parsed %v as %v", bad, layout)
+		}
+	}
+
+	flag := &layoutFlag{}
+	if err := flag.Set(`vinyl=^v(?P<id>\d+)$`); err != nil || flag.String() != "vinyl" {
+		t.Errorf("Bad flag: %v -> %v", flag, err)
+	}
+}
+
+func TestScanLayouts(t *testing.T) {
+	dir, err := os.MkdirTemp("", "cdprocessor")
+	if err != nil {
+		t.Fatalf("Unable to make dir: %v", err)
+	}
+	defer os.RemoveAll(dir)
+	dir += "/"
+
+	for _, path := range []string{"200/disc1", "200/disc2", "201_bonus", "v300", "notarip"} {
+		os.MkdirAll(filepath.Join(dir, path), 0755)
+		os.WriteFile(filepath.Join(dir, path, "track01.cdda.wav"), []byte{}, 0644)
+	}
+
+	s := InitTestServer(dir)
+	vinyl, _ := parseLayout(`vinyl=^v(?P<id>\d+)$`)
+	s.layouts = append([]*ripLayout{vinyl}, defaultLayouts...)
+	s.io = &prodIo{dir: dir, layouts: s.layouts}
+	s.rips = nil
+
+	err = s.buildConfig(context.Background())
+	if err != nil {
+		t.Fatalf("Bad scan: %v", err)
+	}
+
+	expected := []struct {
+		path string
+		id   int32
+		disk int32
+		slug string
+	}{
+		{"200/disc1", 200, 1, ""},
+		{"200/disc2", 200, 2, ""},
+		{"201_bonus", 201, 1, "bonus"},
+		{"v300", 300, 1, ""},
+	}
+	if len(s.rips) != len(expected) {
+		t.Fatalf("Wrong rips: %v", s.rips)
+	}
+	for i, e := range expected {
+		rip := s.rips[i]
+		if rip.GetPath() != e.path || rip.GetId() != e.id || rip.GetDisk() != e.disk || rip.GetSlug() != e.slug {
+			t.Errorf("Bad rip %v: %v", i, rip)
+		}
+		if len(rip.GetTracks()) != 1 || rip.GetTracks()[0].GetDisk() != e.disk || rip.GetTracks()[0].GetWavPath() != e.path+"/track01.cdda.wav" {
+			t.Errorf("Bad tracks in %v: %v", e.path, rip.GetTracks())
+		}
+	}
+
+	// A new disk turns up in an existing nested rip
+	os.MkdirAll(filepath.Join(dir, "200", "disc3"), 0755)
+	_, err = s.updateTop(context.Background(), "200")
+	if err != nil || len(s.rips) != 5 || s.rips[2].GetPath() != "200/disc3" {
+		t.Errorf("Bad update: %v -> %v", s.rips, err)
+	}
+}
+
+func TestTrackFilesFromIndex(t *testing.T) {
+	dir, err := os.MkdirTemp("", "cdprocessor")
+	if err != nil {
+		t.Fatalf("Unable to make dir: %v", err)
+	}
+	defer os.RemoveAll(dir)
+	dir += "/"
+
+	for _, file := range []string{"200/disc1/01. Artist - Title.flac", "200_2_bonus/track01.cdda.wav", "201 - Album/01-Title.flac"} {
+		os.MkdirAll(filepath.Dir(filepath.Join(dir, file)), 0755)
+		os.WriteFile(filepath.Join(dir, file), []byte{}, 0644)
+	}
+
+	s := InitTestServer(dir)
+	s.io = &prodIo{dir: dir, layouts: s.layouts}
+	s.rips = nil
+	s.buildConfig(context.Background())
+
+	double := &pbrc.Record{Release: &pbgd.Release{Id: 200, InstanceId: 2001, FormatQuantity: 2}}
+	single := &pbrc.Record{Release: &pbgd.Release{Id: 201, InstanceId: 2011, FormatQuantity: 1}}
+	expected := []struct {
+		record *pbrc.Record
+		track  *TrackSet
+		cover  string
+		mp3    string
+		flac   string
+	}{
+		{double, &TrackSet{Disk: "1", Position: "1"}, "200/disc1/cover.jpg", "200/disc1/01. Artist - Title.mp3", "200/disc1/01. Artist - Title.flac"},
+		{double, &TrackSet{Disk: "2", Position: "1"}, "200_2_bonus/cover.jpg", "200_2_bonus/track01.cdda.mp3", "200_2_bonus/track01.cdda.flac"},
+		{single, &TrackSet{Disk: "1", Position: "1"}, "201 - Album/cover.jpg", "201 - Album/01-Title.mp3", "201 - Album/01-Title.flac"},
+		// Nothing in the index, so we fall back to the legacy layout
+		{single, &TrackSet{Disk: "1", Position: "2"}, "201/cover.jpg", "201/track02.cdda.mp3", "201/track02.cdda.flac"},
+	}
+	for _, e := range expected {
+		files := s.trackFiles(e.track, e.record)
+		if files.cover != dir+e.cover || files.oldmp3 != dir+e.mp3 || files.oldflac != dir+e.flac {
+			t.Errorf("Bad files for %v/%v: %+v", e.record.GetRelease().GetId(), e.track, files)
+		}
+	}
+
+	if !s.fileExists(s.trackPath(&TrackSet{Disk: "1", Position: "1"}, double)) {
+		t.Errorf("Indexed whipper rip reads as missing")
+	}
+}
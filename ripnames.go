@@ -0,0 +1,57 @@
+package main
+
+import (
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// trackScheme is a naming scheme a ripper uses for the files of a single track
+type trackScheme struct {
+	name    string
+	pattern *regexp.Regexp
+}
+
+// trackSchemes are tried in order; each pattern captures the track number and the extension
+var trackSchemes = []*trackScheme{
+	// track01.cdda.wav, and the mp3 and flac we convert alongside it
+	{name: "cdparanoia", pattern: regexp.MustCompile(`(?i)^track(\d{1,3})(?:\.cdda)?\.(wav|mp3|flac)$`)},
+	// 01. Artist - Title.flac, with 00 for the hidden track
+	{name: "whipper", pattern: regexp.MustCompile(`(?i)^(\d{1,3})\. .+\.(wav|mp3|flac)$`)},
+	// 01.Title.flac or 01-Title.flac
+	{name: "abcde", pattern: regexp.MustCompile(`(?i)^(\d{1,3})[.\-_ ].*\.(wav|mp3|flac)$`)},
+}
+
+// sidecarExtensions are the non audio files rippers leave next to the tracks
+var sidecarExtensions = map[string]bool{
+	".accurip": true,
+	".cue":     true,
+	".jpg":     true,
+	".log":     true,
+	".m3u":     true,
+	".md5":     true,
+	".png":     true,
+	".toc":     true,
+	".txt":     true,
+}
+
+// parseTrackFile works out which track a file in a rip holds and which format it's in
+func parseTrackFile(name string) (int32, string, error) {
+	for _, scheme := range trackSchemes {
+		match := scheme.pattern.FindStringSubmatch(name)
+		if match != nil {
+			track, err := strconv.ParseInt(match[1], 10, 32)
+			if err != nil {
+				return -1, "", fmt.Errorf("bad %v track number in %v: %w", scheme.name, name, err)
+			}
+			return int32(track), strings.ToLower(match[2]), nil
+		}
+	}
+	return -1, "", fmt.Errorf("%v does not match any track naming scheme", name)
+}
+
+func isSidecar(name string) bool {
+	return sidecarExtensions[strings.ToLower(filepath.Ext(name))]
+}
@@ -0,0 +1,73 @@
+package main
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestParseTrackFile(t *testing.T) {
+	tests := []struct {
+		name   string
+		track  int32
+		format string
+	}{
+		{"track01.cdda.wav", 1, "wav"},
+		{"track01.cdda.mp3", 1, "mp3"},
+		{"track00.cdda.wav", 0, "wav"},
+		{"track1.wav", 1, "wav"},
+		{"track123.cdda.flac", 123, "flac"},
+		{"01. Artist - Title.flac", 1, "flac"},
+		{"00. Artist - Hidden Track One Audio.flac", 0, "flac"},
+		{"07.Title.FLAC", 7, "flac"},
+		{"12-Some Title.mp3", 12, "mp3"},
+	}
+
+	for _, test := range tests {
+		track, format, err := parseTrackFile(test.name)
+		if err != nil || track != test.track || format != test.format {
+			t.Errorf("Bad parse of %v: %v, %v, %v", test.name, track, format, err)
+		}
+	}
+
+	for _, bad := range []string{"tracklist.wav", "track.wav", "track01.cdda.ogg", "cover.flac", "1234. Too Long.flac"} {
+		track, format, err := parseTrackFile(bad)
+		if err == nil {
+			t.Errorf("Parsed %v as %v %v", bad, track, format)
+		}
+	}
+}
+
+func TestReadTracksReportsUnrecognised(t *testing.T) {
+	dir, err := os.MkdirTemp("", "cdprocessor")
+	if err != nil {
+		t.Fatalf("Unable to make dir: %v", err)
+	}
+	defer os.RemoveAll(dir)
+	dir += "/"
+
+	os.MkdirAll(filepath.Join(dir, "123"), 0755)
+	for _, name := range []string{"track00.cdda.wav", "track01.cdda.wav", "track01.cdda.mp3", "tracklist.txt", "rip.log", "notes.doc"} {
+		os.WriteFile(filepath.Join(dir, "123", name), []byte{}, 0644)
+	}
+
+	s := InitTestServer(dir)
+	if len(s.rips) != 1 {
+		t.Fatalf("Bad scan: %v", s.rips)
+	}
+
+	rip := s.rips[0]
+	if len(rip.GetTracks()) != 2 || rip.GetTracks()[0].GetTrackNumber() != 0 || rip.GetTracks()[1].GetMp3Path() != "123/track01.cdda.mp3" {
+		t.Errorf("Bad tracks: %v", rip.GetTracks())
+	}
+	if len(rip.GetUnrecognised()) != 1 || rip.GetUnrecognised()[0] != "123/notes.doc" {
+		t.Errorf("Bad unrecognised files: %v", rip.GetUnrecognised())
+	}
+
+	files, _ := s.io.readSubdir("123")
+	tracks, unrecognised := s.tracksFrom(context.Background(), "123", 1, files, nil)
+	if len(tracks) != 2 || len(unrecognised) != 1 {
+		t.Errorf("Bad reread: %v, %v", tracks, unrecognised)
+	}
+}
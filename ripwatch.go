@@ -0,0 +1,268 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/brotherlogic/goserver/utils"
+	"github.com/fsnotify/fsnotify"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/brotherlogic/cdprocessor/proto"
+)
+
+func (s *Server) getRips() []*pb.Rip {
+	s.ripLock.Lock()
+	defer s.ripLock.Unlock()
+	return s.rips
+}
+
+// setRips swaps in a new index; rips are never changed in place once they're in the index
+func (s *Server) setRips(rips []*pb.Rip) {
+	s.ripLock.Lock()
+	defer s.ripLock.Unlock()
+	s.rips = rips
+}
+
+func (s *Server) watchingRips() bool {
+	s.ripLock.Lock()
+	defer s.ripLock.Unlock()
+	return s.ripWatching
+}
+
+func (s *Server) setWatching(watching bool) {
+	s.ripLock.Lock()
+	defer s.ripLock.Unlock()
+	s.ripWatching = watching
+}
+
+// watcher is how to watch a directory, nil unless the watcher is running
+func (s *Server) watcher() func(string) error {
+	s.ripLock.Lock()
+	defer s.ripLock.Unlock()
+	return s.ripWatcher
+}
+
+func (s *Server) setWatcher(add func(string) error) {
+	s.ripLock.Lock()
+	defer s.ripLock.Unlock()
+	s.ripWatcher = add
+}
+
+// rescanUnlessWatching rescans the rips, unless the watcher will pick up the changes for us
+func (s *Server) rescanUnlessWatching(ctx context.Context) {
+	if !s.watchingRips() {
+		s.buildConfig(ctx)
+	}
+}
+
+// updateTop rereads the rips in a single top level directory, dropping them if it has gone
+func (s *Server) updateTop(ctx context.Context, name string) ([]*pb.Rip, error) {
+	s.scanLock.Lock()
+	defer s.scanLock.Unlock()
+
+	current := s.getRips()
+	existing := make(map[string]*pb.Rip)
+	for _, rip := range current {
+		existing[rip.GetPath()] = rip
+	}
+
+	var found []*pb.Rip
+	info, err := os.Stat(filepath.Join(s.dir, name))
+	if err == nil && info.IsDir() && name != "lost+found" {
+		found, err = s.scanTop(ctx, info, existing)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var rips []*pb.Rip
+	placed := false
+	for _, rip := range current {
+		if !underTop(rip, name) {
+			rips = append(rips, rip)
+		} else if !placed {
+			rips = append(rips, found...)
+			placed = true
+		}
+	}
+	if !placed {
+		rips = append(rips, found...)
+	}
+
+	if s.storeRips(ctx, current, rips) {
+		return found, s.saveRipIndex(ctx)
+	}
+	return found, nil
+}
+
+// rescanRip rereads the top level directories holding the rips of a release
+func (s *Server) rescanRip(ctx context.Context, id int32) error {
+	tops := make(map[string]bool)
+	for _, rip := range s.getRips() {
+		if rip.GetId() == id {
+			tops[strings.Split(rip.GetPath(), "/")[0]] = true
+		}
+	}
+	if len(tops) == 0 {
+		return status.Errorf(codes.NotFound, "no rip of %v in the index, rescan without an id to find new rips", id)
+	}
+
+	for top := range tops {
+		if _, err := s.updateTop(ctx, top); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ripName maps a path under the rips directory to the top level directory it is in
+func (s *Server) ripName(path string) string {
+	rel, err := filepath.Rel(s.dir, path)
+	if err != nil || rel == "." || strings.HasPrefix(rel, "..") {
+		return ""
+	}
+	return strings.Split(rel, string(filepath.Separator))[0]
+}
+
+// watchRips keeps the index up to date as files come and go, until the watcher fails
+func (s *Server) watchRips() {
+	ctx, cancel := utils.ManualContext("cdprocessor-watch", time.Minute)
+	watcher, err := fsnotify.NewWatcher()
+	if err == nil {
+		err = watcher.Add(s.dir)
+	}
+	if err != nil {
+		s.CtxLog(ctx, fmt.Sprintf("Unable to watch rips, falling back to scanning: %v", err))
+		cancel()
+		return
+	}
+	defer watcher.Close()
+
+	// Rips stored from here on are watched as they're found, so none slip through
+	s.setWatcher(watcher.Add)
+	defer s.setWatcher(nil)
+	s.addWatches(ctx, watcher.Add, "", s.getRips())
+	cancel()
+
+	s.setWatching(true)
+	defer s.setWatching(false)
+	s.handleWatch(watcher.Events, watcher.Errors, watcher.Add)
+}
+
+// pendingTop is a top level directory with events waiting to be applied
+type pendingTop struct {
+	due     time.Time
+	created bool
+}
+
+// handleWatch applies events to the index. Events are coalesced by top level directory, which
+// is only reread once it has settled; a file being written is left until it has gone quiet.
+func (s *Server) handleWatch(events chan fsnotify.Event, errors chan error, add func(string) error) {
+	pending := make(map[string]*pendingTop)
+	var timer <-chan time.Time
+	for {
+		select {
+		case event, ok := <-events:
+			if !ok {
+				s.flushWatch(pending, add, time.Time{})
+				return
+			}
+
+			name := s.ripName(event.Name)
+			if len(name) == 0 {
+				continue
+			}
+
+			p, ok := pending[name]
+			if !ok {
+				p = &pendingTop{}
+				pending[name] = p
+			}
+			quiet := s.watchSettle
+			if event.Op == fsnotify.Write {
+				quiet = s.watchQuiet
+			}
+			if due := time.Now().Add(quiet); due.After(p.due) {
+				p.due = due
+			}
+			p.created = p.created || event.Has(fsnotify.Create)
+		case <-timer:
+		case err, ok := <-errors:
+			if !ok {
+				return
+			}
+
+			// We may have missed events, so catch up with a full scan
+			ctx, cancel := utils.ManualContext("cdprocessor-watch", time.Minute)
+			s.CtxLog(ctx, fmt.Sprintf("Watch error, rescanning: %v", err))
+			cancel()
+			s.reconcileRips()
+		}
+
+		timer = nil
+		if next := s.flushWatch(pending, add, time.Now()); !next.IsZero() {
+			timer = time.After(time.Until(next))
+		}
+	}
+}
+
+// flushWatch rereads the pending directories which are due by now, or all of them if now
+// is zero, returning when the next of the rest is due
+func (s *Server) flushWatch(pending map[string]*pendingTop, add func(string) error, now time.Time) time.Time {
+	var next time.Time
+	for name, p := range pending {
+		if !now.IsZero() && p.due.After(now) {
+			if next.IsZero() || p.due.Before(next) {
+				next = p.due
+			}
+			continue
+		}
+		delete(pending, name)
+
+		ctx, cancel := utils.ManualContext("cdprocessor-watch", time.Minute)
+		found, err := s.updateTop(ctx, name)
+		if err != nil {
+			s.CtxLog(ctx, fmt.Sprintf("Unable to update %v: %v", name, err))
+		}
+
+		// Pick up new directories, including per disk folders inside them
+		if p.created {
+			s.addWatches(ctx, add, name, found)
+		}
+		cancel()
+	}
+	return next
+}
+
+// addWatches watches the top level directory and the directories of the given rips
+func (s *Server) addWatches(ctx context.Context, add func(string) error, top string, rips []*pb.Rip) {
+	paths := make(map[string]bool)
+	if len(top) > 0 {
+		paths[top] = true
+	}
+	for _, rip := range rips {
+		paths[rip.GetPath()] = true
+		paths[strings.Split(rip.GetPath(), "/")[0]] = true
+	}
+
+	for path := range paths {
+		err := add(filepath.Join(s.dir, path))
+		if err != nil {
+			s.CtxLog(ctx, fmt.Sprintf("Unable to watch %v: %v", path, err))
+		}
+	}
+}
+
+// runRipScan fully rescans the rips every so often, in case the watcher missed anything
+func (s *Server) runRipScan(interval time.Duration) {
+	for {
+		time.Sleep(interval)
+		s.reconcileRips()
+	}
+}
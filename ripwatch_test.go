@@ -0,0 +1,125 @@
+package main
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+
+	pb "github.com/brotherlogic/cdprocessor/proto"
+)
+
+func waitForRips(t *testing.T, s *Server, check func(rips []*pb.Rip) bool) {
+	deadline := time.Now().Add(time.Second * 5)
+	for time.Now().Before(deadline) {
+		if check(s.getRips()) {
+			return
+		}
+		time.Sleep(time.Millisecond * 10)
+	}
+	t.Fatalf("Index never caught up: %v", s.getRips())
+}
+
+func TestWatchRipsUpdatesIndex(t *testing.T) {
+	dir, err := os.MkdirTemp("", "cdprocessor")
+	if err != nil {
+		t.Fatalf("Unable to make dir: %v", err)
+	}
+	defer os.RemoveAll(dir)
+	dir += "/"
+
+	os.MkdirAll(filepath.Join(dir, "123"), 0755)
+	os.WriteFile(filepath.Join(dir, "123", "track01.cdda.wav"), []byte{}, 0644)
+
+	s := InitTestServer(dir)
+	s.watchSettle = time.Millisecond * 10
+	s.watchQuiet = time.Millisecond * 50
+	go s.watchRips()
+	for !s.watchingRips() {
+		time.Sleep(time.Millisecond)
+	}
+
+	os.MkdirAll(filepath.Join(dir, "124_2"), 0755)
+	waitForRips(t, s, func(rips []*pb.Rip) bool { return len(rips) == 2 })
+	os.WriteFile(filepath.Join(dir, "124_2", "track01.cdda.wav"), []byte{}, 0644)
+	waitForRips(t, s, func(rips []*pb.Rip) bool {
+		return len(rips) == 2 && len(rips[1].GetTracks()) == 1 && rips[1].GetTracks()[0].GetDisk() == 2
+	})
+
+	os.WriteFile(filepath.Join(dir, "123", "track01.cdda.mp3"), []byte{}, 0644)
+	waitForRips(t, s, func(rips []*pb.Rip) bool { return rips[0].GetTracks()[0].GetMp3Path() == "123/track01.cdda.mp3" })
+
+	os.RemoveAll(filepath.Join(dir, "124_2"))
+	waitForRips(t, s, func(rips []*pb.Rip) bool { return len(rips) == 1 && rips[0].GetId() == 123 })
+}
+
+func TestWatchRipsFromEmptyIndex(t *testing.T) {
+	dir := t.TempDir() + "/"
+	s := InitTestServer(dir)
+	s.watchSettle = time.Millisecond * 10
+	s.watchQuiet = time.Millisecond * 50
+
+	// A first run has rips on disk but none in the index until the reconcile finishes
+	os.MkdirAll(filepath.Join(dir, "123"), 0755)
+	os.WriteFile(filepath.Join(dir, "123", "track01.cdda.wav"), []byte{}, 0644)
+	go s.watchRips()
+	for !s.watchingRips() {
+		time.Sleep(time.Millisecond)
+	}
+	s.reconcileRips()
+	waitForRips(t, s, func(rips []*pb.Rip) bool { return len(rips) == 1 })
+
+	os.WriteFile(filepath.Join(dir, "123", "track01.cdda.mp3"), []byte{}, 0644)
+	waitForRips(t, s, func(rips []*pb.Rip) bool { return rips[0].GetTracks()[0].GetMp3Path() == "123/track01.cdda.mp3" })
+}
+
+func TestWatchCoalescesEvents(t *testing.T) {
+	dir := t.TempDir() + "/"
+	s := InitTestServer(dir)
+	cio := &countingIo{testIo: testIo{dir: dir}}
+	s.io = cio
+	s.watchSettle = time.Millisecond * 10
+
+	os.MkdirAll(filepath.Join(dir, "125"), 0755)
+	os.WriteFile(filepath.Join(dir, "125", "track01.cdda.wav"), []byte{}, 0644)
+
+	events := make(chan fsnotify.Event)
+	done := make(chan bool)
+	go func() {
+		s.handleWatch(events, make(chan error), func(string) error { return nil })
+		done <- true
+	}()
+
+	// An encoder writing away shouldn't cause a reread per write
+	for i := 0; i < 20; i++ {
+		events <- fsnotify.Event{Name: filepath.Join(dir, "125", "track01.cdda.wav"), Op: fsnotify.Write}
+	}
+	time.Sleep(s.watchSettle * 2)
+	if cio.reads != 0 {
+		t.Errorf("Directory was reread while being written: %v", cio.reads)
+	}
+
+	close(events)
+	<-done
+	if cio.reads != 1 || len(s.getRips()) != 1 {
+		t.Errorf("Events were not coalesced (%v reads): %v", cio.reads, s.getRips())
+	}
+}
+
+func TestConvertSkipsRescanWhenWatching(t *testing.T) {
+	s := InitTestServer("testdata/")
+	cio := &countingIo{testIo: testIo{dir: "testdata/"}}
+	s.io = cio
+	s.setWatching(true)
+
+	err := s.convertToMP3(context.Background(), 12345)
+	if err != nil || s.ripCount != 1 {
+		t.Fatalf("Bad conversion (%v): %v", s.ripCount, err)
+	}
+	if cio.reads != 0 {
+		t.Errorf("Conversion rescanned %v rips while watching", cio.reads)
+	}
+}
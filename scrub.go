@@ -0,0 +1,439 @@
+package main
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	goio "io"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/brotherlogic/goserver/utils"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	pb "github.com/brotherlogic/cdprocessor/proto"
+)
+
+const (
+	// MANIFEST - under which each node stores the checksums of its own verified rips
+	MANIFEST = "/github.com/brotherlogic/cdprocessor/manifest"
+
+	// scrubChunk is how much we hash between checks on the scrub rate
+	scrubChunk = 1024 * 1024
+
+	// scrubSaveEvery is how many files we scrub between saves of the manifest
+	scrubSaveEvery = 100
+
+	// scrubPoll is how often we look for newly verified files to hash
+	scrubPoll = time.Minute * 10
+)
+
+// manifestKey is where this node's manifest is stored
+func (s *Server) manifestKey() string {
+	return fmt.Sprintf("%v/%v", MANIFEST, s.node)
+}
+
+// hashFile computes the SHA-256 of a file, reading no faster than rate bytes a second if rate > 0
+func hashFile(path string, rate int64) (string, int64, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", 0, err
+	}
+	defer f.Close()
+
+	h := sha256.New()
+	buf := make([]byte, scrubChunk)
+	read := int64(0)
+	start := time.Now()
+	for {
+		n, err := f.Read(buf)
+		h.Write(buf[:n])
+		read += int64(n)
+		if errors.Is(err, goio.EOF) {
+			break
+		}
+		if err != nil {
+			return "", read, err
+		}
+
+		if rate > 0 {
+			if ahead := time.Duration(read*int64(time.Second)/rate) - time.Since(start); ahead > 0 {
+				time.Sleep(ahead)
+			}
+		}
+	}
+	return hex.EncodeToString(h.Sum(nil)), read, nil
+}
+
+// checksumFile builds the manifest entry for a file under the rips directory
+func (s *Server) checksumFile(id int32, path string, rate int64) (*pb.FileChecksum, int64, error) {
+	info, err := os.Stat(s.dir + path)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	sum, read, err := hashFile(s.dir+path, rate)
+	if err != nil {
+		return nil, read, err
+	}
+	entry := &pb.FileChecksum{Id: id, Path: path, Size: info.Size(), Mtime: info.ModTime().Unix(), Sha256: sum}
+
+	if strings.HasSuffix(strings.ToLower(path), ".flac") {
+		f, err := os.Open(s.dir + path)
+		if err != nil {
+			return nil, read, err
+		}
+		defer f.Close()
+		stream, err := readStreamInfo(f)
+		if err != nil {
+			return nil, read, err
+		}
+		entry.FlacMd5 = hex.EncodeToString(stream.md5)
+	}
+	return entry, read, nil
+}
+
+// queueChecksums adds the files of a release that has just verified to the manifest, leaving
+// alone any we already hold. They're hashed in the background, at the scrub rate.
+func (s *Server) queueChecksums(ctx context.Context, id int32) error {
+	s.manifestLock.Lock()
+	queued := 0
+	for _, rip := range s.getRips() {
+		if rip.GetId() != id {
+			continue
+		}
+		for _, track := range rip.GetTracks() {
+			for _, path := range []string{track.GetWavPath(), track.GetMp3Path(), track.GetFlacPath()} {
+				if _, ok := s.manifest.GetFiles()[path]; len(path) > 0 && !ok {
+					s.manifest.Files[path] = &pb.FileChecksum{Id: id, Path: path}
+					queued++
+				}
+			}
+		}
+	}
+	if queued > 0 {
+		s.manifestDirty = true
+	}
+	s.manifestLock.Unlock()
+
+	if queued == 0 {
+		return nil
+	}
+	s.CtxLog(ctx, fmt.Sprintf("Queued checksums of %v files for %v", queued, id))
+	return s.saveManifest(ctx)
+}
+
+// pending tells whether a manifest entry is still waiting to be hashed
+func pending(entry *pb.FileChecksum) bool {
+	return len(entry.GetSha256()) == 0
+}
+
+// recordPending hashes the files queued since the last run. Any that can't be read are left
+// queued to try again.
+func (s *Server) recordPending(ctx context.Context) {
+	s.manifestLock.Lock()
+	var entries []*pb.FileChecksum
+	for _, entry := range s.manifest.GetFiles() {
+		if pending(entry) {
+			entries = append(entries, entry)
+		}
+	}
+	s.manifestLock.Unlock()
+
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].GetPath() < entries[j].GetPath()
+	})
+
+	recorded := 0
+	for _, entry := range entries {
+		updated, _, err := s.checksumFile(entry.GetId(), entry.GetPath(), s.scrubRate)
+		if err != nil {
+			s.CtxLog(ctx, fmt.Sprintf("Unable to record checksum of %v: %v", entry.GetPath(), err))
+			continue
+		}
+		updated.Recorded = time.Now().Unix()
+
+		s.manifestLock.Lock()
+		// Leave it be if it was pruned while we read it
+		if current, ok := s.manifest.GetFiles()[entry.GetPath()]; ok && pending(current) {
+			s.manifest.Files[entry.GetPath()] = updated
+			s.manifestDirty = true
+			recorded++
+		}
+		s.manifestLock.Unlock()
+	}
+
+	if recorded > 0 {
+		s.CtxLog(ctx, fmt.Sprintf("Recorded checksums of %v files", recorded))
+		err := s.saveManifest(ctx)
+		if err != nil {
+			s.CtxLog(ctx, fmt.Sprintf("Unable to save manifest: %v", err))
+		}
+	}
+}
+
+// scrubFile rehashes a file from the manifest. Bit rot changes what's in a file without
+// touching its mtime; a file that's been written since it was recorded (e.g. retagged) is
+// taken as the new baseline if its audio is unchanged.
+func (s *Server) scrubFile(entry *pb.FileChecksum) (*pb.FileChecksum, int64) {
+	updated := proto.Clone(entry).(*pb.FileChecksum)
+	updated.LastScrubbed = time.Now().Unix()
+	updated.Problem = ""
+
+	now, read, err := s.checksumFile(entry.GetId(), entry.GetPath(), s.scrubRate)
+	switch {
+	case os.IsNotExist(err):
+		updated.Problem = "missing"
+	case err != nil:
+		updated.Problem = fmt.Sprintf("unreadable: %v", err)
+	case now.GetSize() == entry.GetSize() && now.GetMtime() == entry.GetMtime():
+		if now.GetSha256() != entry.GetSha256() {
+			updated.Problem = fmt.Sprintf("contents changed without being written: sha256 was %v, now %v", entry.GetSha256(), now.GetSha256())
+		}
+	case len(entry.GetFlacMd5()) > 0 && now.GetFlacMd5() != entry.GetFlacMd5():
+		updated.Problem = fmt.Sprintf("audio MD5 changed from %v to %v", entry.GetFlacMd5(), now.GetFlacMd5())
+	default:
+		format := strings.ToLower(entry.GetPath()[strings.LastIndex(entry.GetPath(), ".")+1:])
+		if format == "wav" {
+			f, err := os.Open(s.dir + entry.GetPath())
+			if err == nil {
+				_, err = readWavInfo(f, now.GetSize())
+				f.Close()
+			}
+			if err != nil {
+				updated.Problem = fmt.Sprintf("rewritten and no longer reads: %v", err)
+			}
+		} else if err := checkAudioFile(s.dir+entry.GetPath(), format); isDecodeError(err) {
+			updated.Problem = fmt.Sprintf("rewritten and no longer decodes: %v", err)
+		} else if err != nil {
+			updated.Problem = fmt.Sprintf("unreadable: %v", err)
+		}
+
+		if len(updated.GetProblem()) == 0 {
+			updated.Size = now.GetSize()
+			updated.Mtime = now.GetMtime()
+			updated.Sha256 = now.GetSha256()
+		}
+	}
+	return updated, read
+}
+
+// pruneManifest drops the checksums of files that are no longer part of an indexed rip. The
+// manifest is left alone while the index is empty, so a node yet to scan forgets nothing.
+func (s *Server) pruneManifest() int {
+	rips := s.getRips()
+	if len(rips) == 0 {
+		return 0
+	}
+
+	paths := make(map[string]bool)
+	for _, rip := range rips {
+		for _, track := range rip.GetTracks() {
+			for _, path := range []string{track.GetWavPath(), track.GetMp3Path(), track.GetFlacPath()} {
+				paths[path] = true
+			}
+		}
+	}
+
+	s.manifestLock.Lock()
+	defer s.manifestLock.Unlock()
+	pruned := 0
+	for path := range s.manifest.GetFiles() {
+		if !paths[path] {
+			delete(s.manifest.Files, path)
+			pruned++
+		}
+	}
+	if pruned > 0 {
+		s.manifestDirty = true
+	}
+	return pruned
+}
+
+// scrubPass rehashes every file in the manifest, least recently scrubbed first, and returns
+// the files which have newly gone bad
+func (s *Server) scrubPass(ctx context.Context) []*pb.FileChecksum {
+	if pruned := s.pruneManifest(); pruned > 0 {
+		s.CtxLog(ctx, fmt.Sprintf("Dropped checksums of %v files no longer in a rip", pruned))
+	}
+
+	s.manifestLock.Lock()
+	var entries []*pb.FileChecksum
+	for _, entry := range s.manifest.GetFiles() {
+		if !pending(entry) {
+			entries = append(entries, entry)
+		}
+	}
+	s.scrub = &pb.ScrubProgress{StartTime: time.Now().Unix(), Files: int32(len(entries)),
+		LastFinished: s.scrub.GetLastFinished(), LastDurationSeconds: s.scrub.GetLastDurationSeconds()}
+	s.manifestLock.Unlock()
+
+	sort.Slice(entries, func(i, j int) bool {
+		if entries[i].GetLastScrubbed() != entries[j].GetLastScrubbed() {
+			return entries[i].GetLastScrubbed() < entries[j].GetLastScrubbed()
+		}
+		return entries[i].GetPath() < entries[j].GetPath()
+	})
+
+	var problems []*pb.FileChecksum
+	for i, entry := range entries {
+		s.manifestLock.Lock()
+		s.scrub.Current = entry.GetPath()
+		s.manifestLock.Unlock()
+
+		updated, read := s.scrubFile(entry)
+
+		s.manifestLock.Lock()
+		// Leave it be if it was rerecorded while we read it
+		if current, ok := s.manifest.GetFiles()[entry.GetPath()]; ok && !pending(current) && current.GetRecorded() == entry.GetRecorded() {
+			s.manifest.Files[entry.GetPath()] = updated
+			s.manifestDirty = true
+		}
+		s.scrub.Scrubbed++
+		s.scrub.Bytes += read
+		if len(updated.GetProblem()) > 0 {
+			s.scrub.Problems++
+		}
+		s.manifestLock.Unlock()
+
+		if len(updated.GetProblem()) > 0 && updated.GetProblem() != entry.GetProblem() {
+			s.CtxLog(ctx, fmt.Sprintf("Scrub found %v %v", entry.GetPath(), updated.GetProblem()))
+			s.addHistory(entry.GetId(), 0, pb.HistoryEntry_CHECKSUM_MISMATCH, entry.GetPath(), errors.New(updated.GetProblem()))
+			problems = append(problems, updated)
+		}
+
+		if (i+1)%scrubSaveEvery == 0 {
+			err := s.saveManifest(ctx)
+			if err != nil {
+				s.CtxLog(ctx, fmt.Sprintf("Unable to save manifest: %v", err))
+			}
+		}
+	}
+
+	s.manifestLock.Lock()
+	s.scrub.Current = ""
+	s.scrub.LastFinished = time.Now().Unix()
+	s.scrub.LastDurationSeconds = s.scrub.GetLastFinished() - s.scrub.GetStartTime()
+	s.manifestLock.Unlock()
+
+	err := s.saveManifest(ctx)
+	if err != nil {
+		s.CtxLog(ctx, fmt.Sprintf("Unable to save manifest: %v", err))
+	}
+	return problems
+}
+
+// scrubDue is when the file checked longest ago is next due a scrub
+func (s *Server) scrubDue(interval time.Duration) time.Time {
+	s.manifestLock.Lock()
+	defer s.manifestLock.Unlock()
+
+	due := time.Now().Add(interval)
+	for _, entry := range s.manifest.GetFiles() {
+		if pending(entry) {
+			continue
+		}
+		checked := entry.GetLastScrubbed()
+		if entry.GetRecorded() > checked {
+			checked = entry.GetRecorded()
+		}
+		if next := time.Unix(checked, 0).Add(interval); next.Before(due) {
+			due = next
+		}
+	}
+	return due
+}
+
+// runScrub hashes newly verified files as they're queued, and rehashes the library whenever
+// it's due, including at startup if it's overdue, raising an issue for anything that's gone bad
+func (s *Server) runScrub(interval time.Duration) {
+	for {
+		ctx, cancel := utils.ManualContext("cdprocessor-scrub", interval)
+		s.recordPending(ctx)
+		if !s.scrubDue(interval).After(time.Now()) {
+			problems := s.scrubPass(ctx)
+			if len(problems) > 0 {
+				var lines []string
+				for _, problem := range problems {
+					lines = append(lines, fmt.Sprintf("%v (%v): %v", problem.GetPath(), problem.GetId(), problem.GetProblem()))
+				}
+				s.RaiseIssue("Rip checksum mismatch", strings.Join(lines, "\n"))
+			}
+		}
+		cancel()
+
+		wait := time.Until(s.scrubDue(interval))
+		if wait > scrubPoll {
+			wait = scrubPoll
+		}
+		time.Sleep(wait)
+	}
+}
+
+func (s *Server) loadManifest(ctx context.Context) error {
+	data, err := s.store.load(ctx, s.manifestKey(), &pb.Manifest{})
+	if err != nil {
+		code := status.Convert(err).Code()
+		if code == codes.NotFound || code == codes.InvalidArgument {
+			return nil
+		}
+		return err
+	}
+
+	manifest := data.(*pb.Manifest)
+	if manifest.Files == nil {
+		manifest.Files = make(map[string]*pb.FileChecksum)
+	}
+
+	s.manifestLock.Lock()
+	defer s.manifestLock.Unlock()
+	// Keep anything recorded while we were loading
+	for path, entry := range s.manifest.GetFiles() {
+		manifest.Files[path] = entry
+	}
+	s.manifest = manifest
+	return nil
+}
+
+// saveManifest writes the manifest out, if anything has changed since the last save
+func (s *Server) saveManifest(ctx context.Context) error {
+	s.manifestLock.Lock()
+	if !s.manifestDirty {
+		s.manifestLock.Unlock()
+		return nil
+	}
+	manifest := proto.Clone(s.manifest)
+	s.manifestDirty = false
+	s.manifestLock.Unlock()
+
+	err := s.store.save(ctx, s.manifestKey(), manifest)
+	if err != nil {
+		s.manifestLock.Lock()
+		s.manifestDirty = true
+		s.manifestLock.Unlock()
+	}
+	return err
+}
+
+// GetScrubProgress reports how far through the library the scrub is, and what it has found
+func (s *Server) GetScrubProgress(ctx context.Context, req *pb.GetScrubProgressRequest) (*pb.GetScrubProgressResponse, error) {
+	s.manifestLock.Lock()
+	defer s.manifestLock.Unlock()
+
+	resp := &pb.GetScrubProgressResponse{Progress: proto.Clone(s.scrub).(*pb.ScrubProgress)}
+	for _, entry := range s.manifest.GetFiles() {
+		if len(entry.GetProblem()) > 0 {
+			resp.Problems = append(resp.Problems, proto.Clone(entry).(*pb.FileChecksum))
+		}
+	}
+	sort.Slice(resp.Problems, func(i, j int) bool {
+		return resp.Problems[i].GetPath() < resp.Problems[j].GetPath()
+	})
+	return resp, nil
+}
@@ -0,0 +1,233 @@
+package main
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	pbcdp "github.com/brotherlogic/cdprocessor/proto"
+)
+
+func TestHashFileThrottled(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "data")
+	data := make([]byte, 2*1024*1024)
+	for i := range data {
+		data[i] = byte(i)
+	}
+	os.WriteFile(path, data, 0644)
+
+	start := time.Now()
+	sum, read, err := hashFile(path, 8*1024*1024)
+	if err != nil {
+		t.Fatalf("Bad hash: %v", err)
+	}
+	want := sha256.Sum256(data)
+	if sum != hex.EncodeToString(want[:]) || read != int64(len(data)) {
+		t.Errorf("Bad hash: %v (%v bytes)", sum, read)
+	}
+	if time.Since(start) < time.Millisecond*200 {
+		t.Errorf("Hash was not throttled: %v", time.Since(start))
+	}
+}
+
+func TestScrub(t *testing.T) {
+	dir := t.TempDir() + "/"
+	os.MkdirAll(filepath.Join(dir, "12"), 0755)
+	os.WriteFile(filepath.Join(dir, "12", "track01.cdda.wav"), makeWav(44100, 2, 16, 1), 0644)
+	os.WriteFile(filepath.Join(dir, "12", "track01.cdda.mp3"), makeMp3(50, 0), 0644)
+	os.WriteFile(filepath.Join(dir, "12", "track02.cdda.mp3"), makeMp3(50, 0), 0644)
+	writeFlac(t, filepath.Join(dir, "12", "track01.cdda.flac"), 3)
+
+	s := InitTestServer(dir)
+	s.setRips([]*pbcdp.Rip{
+		{Id: 12, Path: "12", Tracks: []*pbcdp.Track{
+			{Disk: 1, TrackNumber: 1, WavPath: "12/track01.cdda.wav", Mp3Path: "12/track01.cdda.mp3", FlacPath: "12/track01.cdda.flac"},
+			{Disk: 1, TrackNumber: 2, Mp3Path: "12/track02.cdda.mp3"},
+		}},
+	})
+
+	err := s.queueChecksums(context.Background(), 12)
+	if err != nil {
+		t.Fatalf("Unable to queue: %v", err)
+	}
+	s.recordPending(context.Background())
+	if len(s.manifest.GetFiles()) != 4 || len(s.manifest.GetFiles()["12/track01.cdda.flac"].GetFlacMd5()) != 32 {
+		t.Fatalf("Bad manifest: %v", s.manifest)
+	}
+
+	// Rot a byte of the wav without touching its mtime
+	wav := filepath.Join(dir, "12", "track01.cdda.wav")
+	info, _ := os.Stat(wav)
+	data, _ := os.ReadFile(wav)
+	data[len(data)-10] ^= 0x01
+	os.WriteFile(wav, data, 0644)
+	os.Chtimes(wav, info.ModTime(), info.ModTime())
+
+	// Rewrite one mp3 and lose the other
+	later := time.Now().Add(time.Hour)
+	os.WriteFile(filepath.Join(dir, "12", "track01.cdda.mp3"), makeMp3(60, 0), 0644)
+	os.Chtimes(filepath.Join(dir, "12", "track01.cdda.mp3"), later, later)
+	os.Remove(filepath.Join(dir, "12", "track02.cdda.mp3"))
+
+	// Retagging leaves the flac audio alone
+	os.Chtimes(filepath.Join(dir, "12", "track01.cdda.flac"), later, later)
+
+	problems := s.scrubPass(context.Background())
+	if len(problems) != 2 {
+		t.Fatalf("Bad scrub: %v", problems)
+	}
+
+	resp, err := s.GetScrubProgress(context.Background(), &pbcdp.GetScrubProgressRequest{})
+	if err != nil {
+		t.Fatalf("Bad progress: %v", err)
+	}
+	if resp.GetProgress().GetScrubbed() != 4 || resp.GetProgress().GetProblems() != 2 || resp.GetProgress().GetLastFinished() == 0 || len(resp.GetProblems()) != 2 {
+		t.Errorf("Bad progress: %v", resp)
+	}
+	if resp.GetProblems()[0].GetPath() != "12/track01.cdda.wav" || resp.GetProblems()[1].GetProblem() != "missing" {
+		t.Errorf("Wrong problems: %v", resp.GetProblems())
+	}
+	if s.manifest.GetFiles()["12/track01.cdda.mp3"].GetMtime() != later.Unix() {
+		t.Errorf("Rewritten mp3 was not rebaselined: %v", s.manifest.GetFiles()["12/track01.cdda.mp3"])
+	}
+
+	// Problems are only reported the first time they're seen
+	problems = s.scrubPass(context.Background())
+	if len(problems) != 0 {
+		t.Errorf("Problems reported twice: %v", problems)
+	}
+}
+
+func TestScrubDamagedRewrite(t *testing.T) {
+	dir := t.TempDir() + "/"
+	os.MkdirAll(filepath.Join(dir, "12"), 0755)
+	flac := filepath.Join(dir, "12", "track01.cdda.flac")
+	writeFlac(t, flac, 3)
+
+	s := InitTestServer(dir)
+	s.setRips([]*pbcdp.Rip{{Id: 12, Path: "12", Tracks: []*pbcdp.Track{{Disk: 1, TrackNumber: 1, FlacPath: "12/track01.cdda.flac"}}}})
+	err := s.queueChecksums(context.Background(), 12)
+	if err != nil {
+		t.Fatalf("Unable to queue: %v", err)
+	}
+	s.recordPending(context.Background())
+
+	data, _ := os.ReadFile(flac)
+	os.WriteFile(flac, data[:len(data)-500], 0644)
+
+	problems := s.scrubPass(context.Background())
+	if len(problems) != 1 {
+		t.Errorf("Truncated flac passed the scrub: %v", s.manifest)
+	}
+}
+
+func TestScrubDue(t *testing.T) {
+	s := InitTestServer(t.TempDir() + "/")
+	if due := s.scrubDue(time.Hour); due.Before(time.Now().Add(time.Minute * 59)) {
+		t.Errorf("Empty manifest is due a scrub at %v", due)
+	}
+
+	old := time.Now().Add(-time.Hour * 2).Unix()
+	s.manifest.Files["12/track01.cdda.wav"] = &pbcdp.FileChecksum{Sha256: "a", Recorded: old, LastScrubbed: time.Now().Unix()}
+	s.manifest.Files["12/track02.cdda.wav"] = &pbcdp.FileChecksum{Sha256: "b", Recorded: time.Now().Unix()}
+	if due := s.scrubDue(time.Hour); due.Before(time.Now().Add(time.Minute * 59)) {
+		t.Errorf("Recently checked files are due a scrub at %v", due)
+	}
+
+	s.manifest.Files["12/track03.cdda.wav"] = &pbcdp.FileChecksum{Sha256: "c", Recorded: old, LastScrubbed: old}
+	if due := s.scrubDue(time.Hour); due.After(time.Now()) {
+		t.Errorf("Overdue scrub is not due until %v", due)
+	}
+}
+
+func TestScrubPrunesDepartedRips(t *testing.T) {
+	dir := t.TempDir() + "/"
+	os.MkdirAll(filepath.Join(dir, "12"), 0755)
+	os.MkdirAll(filepath.Join(dir, "13"), 0755)
+	os.WriteFile(filepath.Join(dir, "12", "track01.cdda.mp3"), makeMp3(50, 0), 0644)
+	os.WriteFile(filepath.Join(dir, "13", "track01.cdda.mp3"), makeMp3(50, 0), 0644)
+
+	s := InitTestServer(dir)
+	s.setRips([]*pbcdp.Rip{
+		{Id: 12, Path: "12", Tracks: []*pbcdp.Track{{Disk: 1, TrackNumber: 1, Mp3Path: "12/track01.cdda.mp3"}}},
+		{Id: 13, Path: "13", Tracks: []*pbcdp.Track{{Disk: 1, TrackNumber: 1, Mp3Path: "13/track01.cdda.mp3"}}},
+	})
+	s.queueChecksums(context.Background(), 12)
+	s.queueChecksums(context.Background(), 13)
+	s.recordPending(context.Background())
+
+	// 13 is moved off this node
+	os.RemoveAll(filepath.Join(dir, "13"))
+	s.setRips(s.getRips()[:1])
+
+	problems := s.scrubPass(context.Background())
+	if len(problems) != 0 || len(s.manifest.GetFiles()) != 1 || s.manifest.GetFiles()["12/track01.cdda.mp3"] == nil {
+		t.Errorf("Departed rip was scrubbed: %v, %v", problems, s.manifest)
+	}
+
+	// Nothing is dropped before the index is built
+	s.setRips(nil)
+	if pruned := s.pruneManifest(); pruned != 0 || len(s.manifest.GetFiles()) != 1 {
+		t.Errorf("Pruned without an index: %v", s.manifest)
+	}
+}
+
+func TestManifestPerNode(t *testing.T) {
+	s := InitTestServer(t.TempDir() + "/")
+	s.manifest.Files["12/track01.cdda.wav"] = &pbcdp.FileChecksum{Id: 12, Path: "12/track01.cdda.wav"}
+	s.manifestDirty = true
+	err := s.saveManifest(context.Background())
+	if err != nil {
+		t.Fatalf("Unable to save: %v", err)
+	}
+
+	other := Init("testdata/", "testdata/mp3", "testdata/flac")
+	other.SkipLog = true
+	other.store = s.store
+	other.node = s.node + "-other"
+	err = other.loadManifest(context.Background())
+	if err != nil || len(other.manifest.GetFiles()) != 0 {
+		t.Errorf("Another node's manifest was loaded: %v -> %v", other.manifest, err)
+	}
+}
+
+func TestQueueChecksums(t *testing.T) {
+	dir := t.TempDir() + "/"
+	os.MkdirAll(filepath.Join(dir, "12"), 0755)
+	os.WriteFile(filepath.Join(dir, "12", "track01.cdda.mp3"), makeMp3(50, 0), 0644)
+
+	s := InitTestServer(dir)
+	s.setRips([]*pbcdp.Rip{{Id: 12, Path: "12", Tracks: []*pbcdp.Track{
+		{Disk: 1, TrackNumber: 1, Mp3Path: "12/track01.cdda.mp3"},
+		{Disk: 1, TrackNumber: 2, Mp3Path: "12/track02.cdda.mp3"},
+	}}})
+
+	// Verifying doesn't wait on the hashing
+	err := s.queueChecksums(context.Background(), 12)
+	if err != nil {
+		t.Fatalf("Unable to queue: %v", err)
+	}
+	for _, entry := range s.manifest.GetFiles() {
+		if !pending(entry) {
+			t.Errorf("Checksum was computed inline: %v", entry)
+		}
+	}
+	if due := s.scrubDue(time.Hour); due.Before(time.Now().Add(time.Minute * 59)) {
+		t.Errorf("Queued files made a scrub due at %v", due)
+	}
+	if problems := s.scrubPass(context.Background()); len(problems) != 0 {
+		t.Errorf("Queued files were scrubbed: %v", problems)
+	}
+
+	s.recordPending(context.Background())
+	if pending(s.manifest.GetFiles()["12/track01.cdda.mp3"]) || s.manifest.GetFiles()["12/track01.cdda.mp3"].GetRecorded() == 0 {
+		t.Errorf("Checksum was not recorded: %v", s.manifest)
+	}
+	if !pending(s.manifest.GetFiles()["12/track02.cdda.mp3"]) {
+		t.Errorf("Unreadable file was not left queued: %v", s.manifest)
+	}
+}